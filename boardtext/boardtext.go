@@ -0,0 +1,229 @@
+// Package boardtext reads and writes minesweeper boards in a plain-text format
+// shared with other minesweeper tools.
+//
+// A board is a header line holding its width, height and mine count, followed
+// by one line per row with one character per cell:
+//
+//	4x3 2
+//	*2*1
+//	1211
+//	0000
+//
+// where '*' is a mine, a digit is a safe cell and the number of mines around
+// it, '.' is a safe cell whose count isn't given, and '#' is a cell whose
+// contents are hidden. Boards with hidden cells describe a game in progress,
+// and can't be played from.
+package boardtext
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// A Cell is a single cell of a Board, as it is written in the text format.
+type Cell byte
+
+const (
+	Mine   = Cell('*')
+	Safe   = Cell('.') // A safe cell whose neighbouring mines aren't given.
+	Hidden = Cell('#')
+)
+
+// Number returns the Cell for a safe cell with n neighbouring mines.
+func Number(n int) Cell { return Cell('0' + n) }
+
+// Number returns the number of mines neighbouring the Cell, if it has one.
+func (c Cell) Number() (n int, ok bool) {
+	if '0' <= c && c <= '9' {
+		return int(c - '0'), true
+	}
+	return 0, false
+}
+
+func (c Cell) valid() bool {
+	_, isNumber := c.Number()
+	return isNumber || c == Mine || c == Safe || c == Hidden
+}
+
+type Board struct {
+	Width, Height int
+	Mines         int
+	Cells         []Cell // Row by row, from the top left.
+}
+
+// At returns the Cell at the row and column.
+func (b *Board) At(row, col int) Cell { return b.Cells[row*b.Width+col] }
+
+// Complete reports whether every Cell's contents are known.
+func (b *Board) Complete() bool { return !slices.Contains(b.Cells, Hidden) }
+
+// Validate checks the Board can be written in the text format.
+func (b *Board) Validate() error {
+	switch {
+	case b.Width <= 0 || b.Height <= 0:
+		return fmt.Errorf("%w: invalid dimensions (%dx%d)", ErrInvalidBoard, b.Width, b.Height)
+	case len(b.Cells) != b.Width*b.Height:
+		return fmt.Errorf("%w: want %d cells, got %d", ErrInvalidBoard, b.Width*b.Height, len(b.Cells))
+	case b.Mines < 0 || b.Mines > len(b.Cells):
+		return fmt.Errorf("%w: %d mines can't fit on the board", ErrInvalidBoard, b.Mines)
+	}
+
+	var mines int
+	for i, c := range b.Cells {
+		if !c.valid() {
+			return fmt.Errorf("%w: unknown cell %q at %d", ErrInvalidBoard, c, i)
+		}
+		if c == Mine {
+			mines++
+		}
+	}
+
+	switch {
+	case mines > b.Mines:
+		return fmt.Errorf("%w: board holds %d mines, want at most %d", ErrInvalidBoard, mines, b.Mines)
+	case mines != b.Mines && b.Complete():
+		return fmt.Errorf("%w: board holds %d mines, want %d", ErrInvalidBoard, mines, b.Mines)
+	}
+
+	// where every mine is known, the numbers must count them.
+	if !b.Complete() {
+		return nil
+	}
+	for i, c := range b.Cells {
+		n, ok := c.Number()
+		if !ok {
+			continue
+		}
+		if got := b.neighbouringMines(i/b.Width, i%b.Width); got != n {
+			return fmt.Errorf(
+				"%w: cell at %d,%d says %d mines, but neighbours %d", ErrInvalidBoard, i/b.Width, i%b.Width, n, got,
+			)
+		}
+	}
+	return nil
+}
+
+// neighbouringMines counts the Mines around the Cell at the row and column.
+func (b *Board) neighbouringMines(row, col int) int {
+	var n int
+	for r := max(row-1, 0); r <= min(row+1, b.Height-1); r++ {
+		for c := max(col-1, 0); c <= min(col+1, b.Width-1); c++ {
+			if b.At(r, c) == Mine {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// Encode writes the Board to w.
+func Encode(w io.Writer, b *Board) error {
+	if err := b.Validate(); err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	_, _ = fmt.Fprintf(bw, "%dx%d %d\n", b.Width, b.Height, b.Mines)
+	for row := range b.Height {
+		_, _ = bw.Write(cellBytes(b.Cells[row*b.Width : (row+1)*b.Width]))
+		_ = bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+func cellBytes(cells []Cell) []byte {
+	res := make([]byte, len(cells))
+	for i, c := range cells {
+		res[i] = byte(c)
+	}
+	return res
+}
+
+// Decode reads a Board from r.
+func Decode(r io.Reader) (*Board, error) {
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, MaxCells+2) // a whole row, and its line ending
+
+	if !s.Scan() {
+		if err := s.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: missing header", ErrInvalidBoard)
+	}
+
+	var (
+		b      Board
+		header = strings.TrimSpace(s.Text())
+	)
+	if n, err := fmt.Sscanf(header, "%dx%d %d", &b.Width, &b.Height, &b.Mines); err != nil || n != 3 {
+		return nil, fmt.Errorf("%w: malformed header %q", ErrInvalidBoard, header)
+	}
+	// the header must be exactly as written by Encode.
+	if header != fmt.Sprintf("%dx%d %d", b.Width, b.Height, b.Mines) {
+		return nil, fmt.Errorf("%w: malformed header %q", ErrInvalidBoard, header)
+	}
+	if b.Width <= 0 || b.Height <= 0 || b.Width > MaxCells || b.Height > MaxCells || b.Width*b.Height > MaxCells {
+		return nil, fmt.Errorf("%w: invalid dimensions (%dx%d)", ErrInvalidBoard, b.Width, b.Height)
+	}
+
+	b.Cells = make([]Cell, 0, b.Width*b.Height)
+	for row := range b.Height {
+		if !s.Scan() {
+			if err := s.Err(); err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("%w: want %d rows, got %d", ErrInvalidBoard, b.Height, row)
+		}
+
+		line := bytes.TrimRight(s.Bytes(), "\r")
+		if len(line) != b.Width {
+			return nil, fmt.Errorf(
+				"%w: row %d has %d cells, want %d", ErrInvalidBoard, row+1, len(line), b.Width,
+			)
+		}
+		for _, c := range line {
+			b.Cells = append(b.Cells, Cell(c))
+		}
+	}
+
+	// only blank lines may follow the board.
+	for s.Scan() {
+		if len(bytes.TrimSpace(s.Bytes())) != 0 {
+			return nil, fmt.Errorf("%w: unexpected content after the board", ErrInvalidBoard)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// Marshal returns the Board in the text format.
+func Marshal(b *Board) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := Encode(&buf, b); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal parses a Board in the text format.
+func Unmarshal(data []byte) (*Board, error) {
+	return Decode(bytes.NewReader(data))
+}
+
+// MaxCells is the largest number of cells Decode will accept on a Board.
+const MaxCells = 1 << 20
+
+var (
+	ErrInvalidBoard = fmt.Errorf("invalid board")
+	ErrUnsupported  = fmt.Errorf("board can't be written in the text format")
+)
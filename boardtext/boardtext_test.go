@@ -0,0 +1,146 @@
+package boardtext_test
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+	"github.com/nightmarlin/sweeper/boardtext"
+)
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for name, text := range map[string]string{
+		"complete":    "4x3 2\n*2*1\n1211\n0000\n",
+		"in progress": "3x2 1\n##1\n001\n",
+		"unnumbered":  "2x2 1\n*.\n..\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := boardtext.Unmarshal([]byte(text))
+			if err != nil {
+				t.Fatalf("decoding: %v", err)
+			}
+			got, err := boardtext.Marshal(b)
+			if err != nil {
+				t.Fatalf("encoding: %v", err)
+			}
+			if string(got) != text {
+				t.Errorf("want\n%s\ngot\n%s", text, got)
+			}
+		})
+	}
+}
+
+func TestDecode_invalid(t *testing.T) {
+	t.Parallel()
+
+	for name, text := range map[string]string{
+		"empty":             "",
+		"malformed header":  "4 by 3\n",
+		"padded header":     "2x1  1\n*.\n",
+		"missing rows":      "2x2 1\n*.\n",
+		"short row":         "2x2 1\n*.\n.\n",
+		"unknown cell":      "2x1 1\n*x\n",
+		"too many mines":    "2x1 1\n**\n",
+		"too few mines":     "2x1 2\n*.\n",
+		"trailing content":  "2x1 1\n*.\n..\n",
+		"negative size":     "-2x1 1\n*.\n",
+		"enormous size":     "1048577x1048577 1\n",
+		"mine count > size": "1x1 2\n#\n",
+		"wrong number":      "3x1 1\n*2.\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := boardtext.Unmarshal([]byte(text)); !errors.Is(err, boardtext.ErrInvalidBoard) {
+				t.Errorf("want error %v, got %v", boardtext.ErrInvalidBoard, err)
+			}
+		})
+	}
+}
+
+func FuzzDecode(f *testing.F) {
+	f.Add([]byte("4x3 2\n*2*1\n1211\n0000\n"))
+	f.Add([]byte("3x2 1\n##1\n001\n"))
+	f.Add([]byte("2x2 1\r\n*.\r\n..\r\n\r\n"))
+	f.Add([]byte("1x1 0\n0"))
+	f.Add([]byte("3x1 1\n*2.\n"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		b, err := boardtext.Unmarshal(data)
+		if err != nil {
+			return
+		}
+
+		// anything that decodes must survive a round trip unchanged.
+		text, err := boardtext.Marshal(b)
+		if err != nil {
+			t.Fatalf("encoding decoded board: %v", err)
+		}
+		again, err := boardtext.Unmarshal(text)
+		if err != nil {
+			t.Fatalf("decoding encoded board: %v\n%s", err, text)
+		}
+		if again.Width != b.Width || again.Height != b.Height || again.Mines != b.Mines ||
+			!slices.Equal(again.Cells, b.Cells) {
+			t.Errorf("round trip changed board: want %+v, got %+v", b, again)
+		}
+		if text2, _ := boardtext.Marshal(again); !bytes.Equal(text, text2) {
+			t.Errorf("encoding is unstable: %q != %q", text, text2)
+		}
+	})
+}
+
+func TestFromGame(t *testing.T) {
+	t.Parallel()
+
+	board, layout, err := sweeper.ParseLayout("*..\n...\n..*\n")
+	if err != nil {
+		t.Fatalf("parsing layout: %v", err)
+	}
+	layout.Reveal = &sweeper.CellRef{Column: 2}
+
+	g, err := sweeper.NewGameFromLayout(uuid.New, board, layout)
+	if err != nil {
+		t.Fatalf("creating game: %v", err)
+	}
+
+	for _, tc := range []struct {
+		complete bool
+		want     string
+	}{
+		{complete: false, want: "3x3 2\n#10\n#21\n###\n"},
+		{complete: true, want: "3x3 2\n*10\n121\n01*\n"},
+	} {
+		b, err := boardtext.FromGame(g, tc.complete)
+		if err != nil {
+			t.Fatalf("converting game: %v", err)
+		}
+		text, err := boardtext.Marshal(b)
+		if err != nil {
+			t.Fatalf("encoding: %v", err)
+		}
+		if string(text) != tc.want {
+			t.Errorf("complete=%t: want\n%s\ngot\n%s", tc.complete, tc.want, text)
+		}
+	}
+
+	b, err := boardtext.FromGame(g, true)
+	if err != nil {
+		t.Fatalf("converting game: %v", err)
+	}
+	_, got, err := b.Layout()
+	if err != nil {
+		t.Fatalf("getting layout: %v", err)
+	}
+	if !slices.Equal(got.Mines, layout.Mines) {
+		t.Errorf("want mines %v, got %v", layout.Mines, got.Mines)
+	}
+}
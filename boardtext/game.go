@@ -0,0 +1,74 @@
+package boardtext
+
+import (
+	"fmt"
+
+	"github.com/nightmarlin/sweeper"
+)
+
+// FromGame returns the Board of the Game. Unless complete is set, Cells that
+// haven't been revealed are Hidden, so where the mines are stays secret.
+//
+// Only flat square boards with at most one mine per cell and no wrapping can be
+// written in the text format.
+func FromGame(g *sweeper.Game, complete bool) (*Board, error) {
+	if err := supported(g.Board); err != nil {
+		return nil, err
+	}
+
+	b := &Board{
+		Width:  g.Board.Width,
+		Height: g.Board.Height,
+		Mines:  g.Board.Mines,
+		Cells:  make([]Cell, 0, g.Board.Width*g.Board.Height),
+	}
+	for row := range g.Board.Height {
+		for col := range g.Board.Width {
+			c := g.Cells.At(sweeper.CellRef{Row: row, Column: col})
+			switch {
+			case !complete && c.State != sweeper.CellRevealed:
+				b.Cells = append(b.Cells, Hidden)
+			case c.ContainsMine():
+				b.Cells = append(b.Cells, Mine)
+			default:
+				b.Cells = append(b.Cells, Number(c.NeighbouringMines))
+			}
+		}
+	}
+	return b, nil
+}
+
+// Layout returns a sweeper.Board and sweeper.Layout to start a Game from the
+// Board. The Board must be Complete.
+func (b *Board) Layout() (sweeper.Board, sweeper.Layout, error) {
+	if err := b.Validate(); err != nil {
+		return sweeper.Board{}, sweeper.Layout{}, err
+	}
+	if !b.Complete() {
+		return sweeper.Board{}, sweeper.Layout{}, fmt.Errorf(
+			"%w: board has hidden cells", ErrInvalidBoard,
+		)
+	}
+
+	var layout sweeper.Layout
+	for i, c := range b.Cells {
+		if c == Mine {
+			layout.Mines = append(layout.Mines, sweeper.CellRef{Row: i / b.Width, Column: i % b.Width})
+		}
+	}
+	return sweeper.Board{Width: b.Width, Height: b.Height, Mines: b.Mines}, layout, nil
+}
+
+func supported(b sweeper.Board) error {
+	switch {
+	case b.Topology != sweeper.TopologySquare:
+		return fmt.Errorf("%w: %s topology", ErrUnsupported, b.Topology)
+	case b.Depth > 1:
+		return fmt.Errorf("%w: boards with depth", ErrUnsupported)
+	case b.Wrap:
+		return fmt.Errorf("%w: wrapping boards", ErrUnsupported)
+	case b.MaxMinesPerCell > 1:
+		return fmt.Errorf("%w: multiple mines per cell", ErrUnsupported)
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+// Package bot plays Games automatically, so solving strategies can be compared.
+package bot
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/nightmarlin/sweeper"
+)
+
+// A Player decides which move to make next in a Game. The Game it is given
+// only shows what its players can see: the contents of unrevealed Cells are
+// never included.
+type Player interface {
+	Move(ctx context.Context, g *sweeper.Game) (sweeper.CellMove, error)
+}
+
+// PlayerFunc adapts a function to a Player.
+type PlayerFunc func(ctx context.Context, g *sweeper.Game) (sweeper.CellMove, error)
+
+func (f PlayerFunc) Move(ctx context.Context, g *sweeper.Game) (sweeper.CellMove, error) {
+	return f(ctx, g)
+}
+
+// Logic is the reference Player. It makes any move it can deduce from the
+// numbers on the Board, and otherwise guesses the Cell least likely to hold a
+// mine. Under sweeper.RulesFlags it claims the mines it finds instead of
+// flagging them, and guesses the Cell most likely to hold one.
+type Logic struct{}
+
+// constraint says that the unknown Cells hold mines between them.
+type constraint struct {
+	unknown []sweeper.CellRef
+	mines   int
+}
+
+func (Logic) Move(ctx context.Context, g *sweeper.Game) (sweeper.CellMove, error) {
+	unknown := unknownCells(g)
+	if len(unknown) == 0 {
+		return sweeper.CellMove{}, ErrNoMoves
+	}
+
+	constraints := findConstraints(g)
+	m, ok, err := deduce(ctx, g, unknown, constraints)
+	if err != nil || ok {
+		return m, err
+	}
+	return guess(g, unknown, constraints), nil
+}
+
+// Deduce returns a move that the numbers on the Board prove to be right, or
+// false if there isn't one and the next move would have to be a guess.
+func Deduce(ctx context.Context, g *sweeper.Game) (sweeper.CellMove, bool, error) {
+	unknown := unknownCells(g)
+	if len(unknown) == 0 {
+		return sweeper.CellMove{}, false, nil
+	}
+	return deduce(ctx, g, unknown, findConstraints(g))
+}
+
+func deduce(
+	ctx context.Context,
+	g *sweeper.Game,
+	unknown []sweeper.CellRef,
+	constraints []constraint,
+) (sweeper.CellMove, bool, error) {
+	perCell := max(g.Board.MaxMinesPerCell, 1)
+
+	mine := func(ref sweeper.CellRef) sweeper.CellMove {
+		if g.Board.Rules == sweeper.RulesFlags {
+			return sweeper.CellMove{Ref: ref, State: sweeper.CellRevealed}
+		}
+		return sweeper.CellMove{Ref: ref, State: sweeper.CellFlagged}
+	}
+	safe := func(ref sweeper.CellRef) sweeper.CellMove {
+		return sweeper.CellMove{Ref: ref, State: sweeper.CellRevealed}
+	}
+
+	// a constraint is decided if its cells must all be safe, or all be full.
+	for _, c := range constraints {
+		switch {
+		case c.mines <= 0:
+			return safe(c.unknown[0]), true, nil
+		case perCell == 1 && c.mines == len(c.unknown):
+			return mine(c.unknown[0]), true, nil
+		}
+	}
+
+	// the mines left over settle every unknown cell once none or all are safe.
+	switch left := g.Board.Mines - knownMines(g); {
+	case left <= 0:
+		return safe(unknown[0]), true, nil
+	case perCell == 1 && left == len(unknown):
+		return mine(unknown[0]), true, nil
+	}
+
+	// when one constraint's cells are all among another's, the other's remaining
+	// cells hold the difference in their mines.
+	for i, a := range constraints {
+		if err := ctx.Err(); err != nil {
+			return sweeper.CellMove{}, false, err
+		}
+		for j, b := range constraints {
+			if i == j || len(a.unknown) >= len(b.unknown) || !isSubset(a.unknown, b.unknown) {
+				continue
+			}
+
+			diff := slices.DeleteFunc(slices.Clone(b.unknown), func(r sweeper.CellRef) bool {
+				return slices.Contains(a.unknown, r)
+			})
+			switch mines := b.mines - a.mines; {
+			case mines == 0:
+				return safe(diff[0]), true, nil
+			case perCell == 1 && mines == len(diff):
+				return mine(diff[0]), true, nil
+			}
+		}
+	}
+
+	return sweeper.CellMove{}, false, nil
+}
+
+// guess picks the unknown Cell least likely to hold a mine, or the most likely
+// under sweeper.RulesFlags.
+func guess(g *sweeper.Game, unknown []sweeper.CellRef, constraints []constraint) sweeper.CellMove {
+	// the chance of a mine in cells next to the numbers is estimated from the
+	// most telling number, and everywhere else from the mines left over.
+	chance := make(map[sweeper.CellRef]float64, len(unknown))
+	for _, c := range constraints {
+		p := float64(c.mines) / float64(len(c.unknown))
+		for _, ref := range c.unknown {
+			chance[ref] = max(chance[ref], p)
+		}
+	}
+
+	left := g.Board.Mines - knownMines(g)
+	var elsewhere int
+	for _, ref := range unknown {
+		if _, ok := chance[ref]; !ok {
+			elsewhere++
+		}
+	}
+	density := 1.0
+	if elsewhere > 0 {
+		density = float64(max(left, 0)) / float64(elsewhere)
+	}
+
+	best, bestChance := unknown[0], 2.0
+	for _, ref := range unknown {
+		p, ok := chance[ref]
+		if !ok {
+			p = density
+		}
+		if g.Board.Rules == sweeper.RulesFlags {
+			p = 1 - p
+		}
+		if p < bestChance {
+			best, bestChance = ref, p
+		}
+	}
+	return sweeper.CellMove{Ref: best, State: sweeper.CellRevealed}
+}
+
+// unknownCells returns every Cell that hasn't been revealed or flagged, in
+// order.
+func unknownCells(g *sweeper.Game) []sweeper.CellRef {
+	var res []sweeper.CellRef
+	g.Cells.Each(func(ref sweeper.CellRef, c sweeper.Cell) {
+		if c.State == sweeper.CellDefault || c.State == sweeper.CellQuestioned {
+			res = append(res, ref)
+		}
+	})
+	return res
+}
+
+// findConstraints returns what each revealed number says about its unknown
+// neighbours, taking the flags and mines around it into account.
+func findConstraints(g *sweeper.Game) []constraint {
+	var res []constraint
+	for i := range g.Cells.Len() {
+		ref, c := g.Cells.Ref(i), g.Cells.Nth(i)
+		if c.State != sweeper.CellRevealed || c.ContainsMine() {
+			continue
+		}
+
+		con := constraint{mines: c.NeighbouringMines}
+		for _, n := range g.Board.Neighbours(ref) {
+			switch nc := g.Cells.At(n); nc.State {
+			case sweeper.CellDefault, sweeper.CellQuestioned:
+				con.unknown = append(con.unknown, n)
+			case sweeper.CellFlagged:
+				con.mines -= nc.Flags
+			case sweeper.CellRevealed:
+				con.mines -= nc.Mines
+			}
+		}
+		if len(con.unknown) > 0 {
+			slices.SortFunc(con.unknown, compareRefs)
+			res = append(res, con)
+		}
+	}
+
+	slices.SortFunc(res, func(a, b constraint) int { return compareRefs(a.unknown[0], b.unknown[0]) })
+	return res
+}
+
+// knownMines returns how many mines have been flagged or revealed.
+func knownMines(g *sweeper.Game) int {
+	var n int
+	for i := range g.Cells.Len() {
+		switch c := g.Cells.Nth(i); c.State {
+		case sweeper.CellFlagged:
+			n += c.Flags
+		case sweeper.CellRevealed:
+			n += c.Mines
+		}
+	}
+	return n
+}
+
+// isSubset reports whether every ref in a is in b. Both must be sorted.
+func isSubset(a, b []sweeper.CellRef) bool {
+	for _, ref := range a {
+		if _, ok := slices.BinarySearchFunc(b, ref, compareRefs); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func compareRefs(a, b sweeper.CellRef) int {
+	return cmp.Or(cmp.Compare(a.Layer, b.Layer), cmp.Compare(a.Row, b.Row), cmp.Compare(a.Column, b.Column))
+}
+
+var ErrNoMoves = fmt.Errorf("no moves left to make")
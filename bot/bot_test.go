@@ -0,0 +1,153 @@
+package bot_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+	"github.com/nightmarlin/sweeper/bot"
+	"github.com/nightmarlin/sweeper/infra/memory"
+	"github.com/nightmarlin/sweeper/internal/fakeclock"
+)
+
+func TestLogic_Move(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx  = context.Background()
+		now  = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		mine = sweeper.CellRef{Column: 2}
+	)
+
+	board, layout, err := sweeper.ParseLayout("..*.o\n")
+	if err != nil {
+		t.Fatalf("parsing layout: %v", err)
+	}
+	g, err := sweeper.NewGameFromLayout(uuid.New, board, layout)
+	if err != nil {
+		t.Fatalf("creating game: %v", err)
+	}
+
+	// the revealed 1 is only next to one unknown cell, so it must be the mine.
+	m, err := bot.Logic{}.Move(ctx, g.SpectatorView())
+	if err != nil {
+		t.Fatalf("choosing move: %v", err)
+	}
+	if want := (sweeper.CellMove{Ref: mine, State: sweeper.CellFlagged}); m != want {
+		t.Fatalf("want move %+v, got %+v", want, m)
+	}
+
+	for g.State == sweeper.GameOngoing {
+		m, err := bot.Logic{}.Move(ctx, g.SpectatorView())
+		if err != nil {
+			t.Fatalf("choosing move: %v", err)
+		}
+		if err := g.Apply(sweeper.Move{Ref: m.Ref, State: m.State, At: now}); err != nil {
+			t.Fatalf("applying %+v: %v", m, err)
+		}
+	}
+	if g.State != sweeper.GameWon {
+		t.Errorf("want state %v, got %v", sweeper.GameWon, g.State)
+	}
+}
+
+func TestRunner_Run(t *testing.T) {
+	t.Parallel()
+
+	var (
+		clock  = fakeclock.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		svc    = sweeper.NewService(memory.NewStore(), uuid.New, sweeper.SeededNumberGenerator(1), clock)
+		runner = bot.Runner{Client: bot.ServiceClient(svc, "bot"), Player: bot.Logic{}, Games: 20}
+	)
+
+	res, err := runner.Run(
+		context.Background(),
+		bot.Preset{Name: "tiny", Board: sweeper.Board{Width: 5, Height: 5, Mines: 3}},
+		bot.Preset{Name: "beginner", Board: sweeper.Board{Width: 9, Height: 9, Mines: 10}},
+	)
+	if err != nil {
+		t.Fatalf("running: %v", err)
+	}
+	if len(res) != 2 {
+		t.Fatalf("want 2 results, got %d", len(res))
+	}
+
+	for _, r := range res {
+		if r.Games != runner.Games {
+			t.Errorf("%s: want %d games, got %d", r.Preset.Name, runner.Games, r.Games)
+		}
+		// sparse boards are mostly solvable by logic alone.
+		if r.WinRate() < 0.5 {
+			t.Errorf("%s: want win rate of at least 50%%, got %.0f%%", r.Preset.Name, 100*r.WinRate())
+		}
+		if r.Moves < r.Games {
+			t.Errorf("%s: want at least a move per game, got %d", r.Preset.Name, r.Moves)
+		}
+	}
+}
+
+func TestRunner_Run_resigns(t *testing.T) {
+	t.Parallel()
+
+	for name, p := range map[string]bot.PlayerFunc{
+		"player fails": func(context.Context, *sweeper.Game) (sweeper.CellMove, error) {
+			return sweeper.CellMove{}, bot.ErrNoMoves
+		},
+		// flagging and clearing the same cell forever never makes progress.
+		"player is stuck": func(_ context.Context, g *sweeper.Game) (sweeper.CellMove, error) {
+			for i := range g.Cells.Len() {
+				switch g.Cells.Nth(i).State {
+				case sweeper.CellRevealed:
+				case sweeper.CellFlagged:
+					return sweeper.CellMove{Ref: g.Cells.Ref(i), State: sweeper.CellDefault}, nil
+				default:
+					return sweeper.CellMove{Ref: g.Cells.Ref(i), State: sweeper.CellFlagged}, nil
+				}
+			}
+			return sweeper.CellMove{}, bot.ErrNoMoves
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				svc = sweeper.NewService(
+					memory.NewStore(), uuid.New, sweeper.SeededNumberGenerator(1), fakeclock.New(time.Now()),
+				)
+				ids []uuid.UUID
+			)
+			player := bot.PlayerFunc(func(ctx context.Context, g *sweeper.Game) (sweeper.CellMove, error) {
+				if !slices.Contains(ids, g.ID) {
+					ids = append(ids, g.ID)
+				}
+				return p(ctx, g)
+			})
+
+			runner := bot.Runner{Client: bot.ServiceClient(svc, "bot"), Player: player, Games: 3}
+			res, err := runner.Run(context.Background(), bot.Preset{Name: "tiny", Board: sweeper.Board{Width: 5, Height: 5, Mines: 3}})
+			if err != nil {
+				t.Fatalf("running: %v", err)
+			}
+			if r := res[0]; r.Games != 3 || r.Resigned != 3 || r.Wins != 0 {
+				t.Errorf("want 3 games resigned, got %+v", r)
+			}
+
+			if len(ids) != 3 {
+				t.Fatalf("want 3 games played, got %d", len(ids))
+			}
+			for _, id := range ids {
+				g, err := svc.GetGame(context.Background(), id)
+				if err != nil {
+					t.Fatalf("getting game: %v", err)
+				}
+				if g.State != sweeper.GameResigned {
+					t.Errorf("want game %s %v, got %v", id, sweeper.GameResigned, g.State)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package bot
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/nightmarlin/sweeper"
+	sweeperv1 "github.com/nightmarlin/sweeper/gen/sweeper/v1"
+	"github.com/nightmarlin/sweeper/gen/sweeper/v1/sweeperv1connect"
+)
+
+// A Client plays Games on behalf of a bot. The Games it returns only show
+// what the bot's player can see.
+type Client interface {
+	StartGame(ctx context.Context, board sweeper.Board) (*sweeper.Game, error)
+	MakeMove(ctx context.Context, gameID uuid.UUID, m sweeper.CellMove) (*sweeper.Game, error)
+	EndGame(ctx context.Context, gameID uuid.UUID) (*sweeper.Game, error)
+}
+
+// ServiceClient returns a Client that plays in-process through the Service,
+// as the player.
+func ServiceClient(svc sweeper.Service, player sweeper.PlayerID) Client {
+	return serviceClient{svc: svc, player: player}
+}
+
+type serviceClient struct {
+	svc    sweeper.Service
+	player sweeper.PlayerID
+}
+
+func (c serviceClient) StartGame(ctx context.Context, board sweeper.Board) (*sweeper.Game, error) {
+	g, err := c.svc.StartGame(ctx, c.player, board)
+	if err != nil {
+		return nil, err
+	}
+	return g.SpectatorView(), nil
+}
+
+func (c serviceClient) MakeMove(ctx context.Context, gameID uuid.UUID, m sweeper.CellMove) (*sweeper.Game, error) {
+	g, _, err := c.svc.MakeMoves(ctx, gameID, c.player, []sweeper.CellMove{m})
+	if err != nil {
+		return nil, err
+	}
+	return g.SpectatorView(), nil
+}
+
+func (c serviceClient) EndGame(ctx context.Context, gameID uuid.UUID) (*sweeper.Game, error) {
+	g, err := c.svc.EndGame(ctx, gameID, c.player)
+	if err != nil {
+		return nil, err
+	}
+	return g.SpectatorView(), nil
+}
+
+// ConnectClient returns a Client that plays on a remote server, as the player.
+func ConnectClient(c sweeperv1connect.SweeperServiceClient, player string) Client {
+	return connectClient{c: c, player: player}
+}
+
+type connectClient struct {
+	c      sweeperv1connect.SweeperServiceClient
+	player string
+}
+
+func (c connectClient) StartGame(ctx context.Context, board sweeper.Board) (*sweeper.Game, error) {
+	res, err := c.c.StartGame(
+		ctx,
+		&connect.Request[sweeperv1.StartGameRequest]{
+			Msg: &sweeperv1.StartGameRequest{
+				Board:    sweeperv1.InternalBoardToBoard(board),
+				PlayerId: c.player,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return sweeperv1.GameToInternalGame(res.Msg.Game)
+}
+
+func (c connectClient) MakeMove(ctx context.Context, gameID uuid.UUID, m sweeper.CellMove) (*sweeper.Game, error) {
+	action := sweeperv1.CellMoveAction_CHORD
+	if !m.Chord {
+		action = cellStateToCellMoveAction(m.State)
+	}
+
+	res, err := c.c.MakeMove(
+		ctx,
+		&connect.Request[sweeperv1.MakeMoveRequest]{
+			Msg: &sweeperv1.MakeMoveRequest{
+				GameId:   gameID.String(),
+				PlayerId: c.player,
+				Move: &sweeperv1.MakeMoveRequest_Cell{
+					Cell: &sweeperv1.CellMove{
+						Layer:  int32(m.Ref.Layer),
+						Row:    int32(m.Ref.Row),
+						Column: int32(m.Ref.Column),
+						Action: action,
+					},
+				},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return sweeperv1.GameToInternalGame(res.Msg.Game)
+}
+
+func (c connectClient) EndGame(ctx context.Context, gameID uuid.UUID) (*sweeper.Game, error) {
+	res, err := c.c.MakeMove(
+		ctx,
+		&connect.Request[sweeperv1.MakeMoveRequest]{
+			Msg: &sweeperv1.MakeMoveRequest{
+				GameId:   gameID.String(),
+				PlayerId: c.player,
+				Move:     &sweeperv1.MakeMoveRequest_End{End: &emptypb.Empty{}},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return sweeperv1.GameToInternalGame(res.Msg.Game)
+}
+
+func cellStateToCellMoveAction(s sweeper.CellState) sweeperv1.CellMoveAction {
+	switch s {
+	case sweeper.CellFlagged:
+		return sweeperv1.CellMoveAction_FLAG
+	case sweeper.CellQuestioned:
+		return sweeperv1.CellMoveAction_QUESTION
+	case sweeper.CellRevealed:
+		return sweeperv1.CellMoveAction_REVEAL
+	default:
+		return sweeperv1.CellMoveAction_CLEAR
+	}
+}
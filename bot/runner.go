@@ -0,0 +1,153 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nightmarlin/sweeper"
+)
+
+// A Preset is a kind of Board to play Games on.
+type Preset struct {
+	Name  string
+	Board sweeper.Board
+}
+
+// Result summarises the Games played on a Preset.
+type Result struct {
+	Preset Preset
+	Games  int
+	Wins   int
+	Moves  int
+
+	// Resigned is how many Games the Player gave up, having failed to choose a
+	// move or stopped making progress. They count as losses.
+	Resigned int
+
+	Total   time.Duration // How long every Game took together.
+	Fastest time.Duration // The fastest Game won. Zero if none were.
+	Slowest time.Duration // The slowest Game won. Zero if none were.
+}
+
+// WinRate returns the fraction of Games that were won.
+func (r Result) WinRate() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return float64(r.Wins) / float64(r.Games)
+}
+
+// MeanGame returns how long each Game took on average.
+func (r Result) MeanGame() time.Duration {
+	if r.Games == 0 {
+		return 0
+	}
+	return r.Total / time.Duration(r.Games)
+}
+
+// MeanMove returns how long each move took on average, including the time
+// taken to apply it.
+func (r Result) MeanMove() time.Duration {
+	if r.Moves == 0 {
+		return 0
+	}
+	return r.Total / time.Duration(r.Moves)
+}
+
+// A Runner has a Player play Games through a Client.
+type Runner struct {
+	Client Client
+	Player Player
+	Games  int // How many Games to play on each Preset.
+
+	// Now tells the time Games are timed by. If nil, time.Now is used.
+	Now func() time.Time
+}
+
+// Run plays Runner.Games Games on each Preset in turn, returning a Result for
+// each. It stops early if a Game can't be started or played on, or ctx is
+// cancelled.
+func (r Runner) Run(ctx context.Context, presets ...Preset) ([]Result, error) {
+	now := r.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	res := make([]Result, 0, len(presets))
+	for _, p := range presets {
+		result := Result{Preset: p}
+		for i := range r.Games {
+			start := now()
+			state, moves, err := r.play(ctx, p.Board)
+			if err != nil {
+				return res, fmt.Errorf("playing game %d on %s: %w", i, p.Name, err)
+			}
+			took := now().Sub(start)
+
+			result.Games++
+			result.Moves += moves
+			result.Total += took
+			switch state {
+			case sweeper.GameResigned:
+				result.Resigned++
+			case sweeper.GameWon:
+				result.Wins++
+				if result.Fastest == 0 || took < result.Fastest {
+					result.Fastest = took
+				}
+				result.Slowest = max(result.Slowest, took)
+			}
+		}
+		res = append(res, result)
+	}
+	return res, nil
+}
+
+// play plays a single Game on the Board, reporting the state it finished in and
+// how many moves it took. If the Player fails to choose a move or stops making
+// progress, the Game is resigned rather than left ongoing.
+func (r Runner) play(ctx context.Context, board sweeper.Board) (state sweeper.GameState, moves int, err error) {
+	g, err := r.Client.StartGame(ctx, board)
+	if err != nil {
+		return 0, 0, fmt.Errorf("starting game: %w", err)
+	}
+
+	// every move changes a cell, and each cell can only be flagged and revealed,
+	// so more moves than this means the Player is going round in circles.
+	limit := 2 * g.Cells.Len()
+	for g.State == sweeper.GameOngoing {
+		if moves >= limit {
+			return r.resign(ctx, g, moves, nil)
+		}
+
+		m, err := r.Player.Move(ctx, g)
+		if err != nil {
+			if ctx.Err() != nil {
+				return r.resign(ctx, g, moves, fmt.Errorf("choosing move: %w", err))
+			}
+			return r.resign(ctx, g, moves, nil)
+		}
+
+		next, err := r.Client.MakeMove(ctx, g.ID, m)
+		if err != nil {
+			return r.resign(ctx, g, moves, fmt.Errorf("making move %d: %w", moves, err))
+		}
+		g = next
+		moves++
+	}
+	return g.State, moves, nil
+}
+
+// resign ends the Game, returning cause if it is set. The Game is ended even if
+// ctx has been cancelled, so it isn't left ongoing.
+func (r Runner) resign(ctx context.Context, g *sweeper.Game, moves int, cause error) (sweeper.GameState, int, error) {
+	if _, err := r.Client.EndGame(context.WithoutCancel(ctx), g.ID); err != nil {
+		return 0, moves, errors.Join(cause, fmt.Errorf("ending game: %w", err))
+	}
+	if cause != nil {
+		return 0, moves, cause
+	}
+	return sweeper.GameResigned, moves, nil
+}
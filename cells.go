@@ -0,0 +1,69 @@
+package sweeper
+
+import "slices"
+
+// Cells holds every Cell on a Board in a single slice, along each row, then
+// down each layer, so that even huge Boards are cheap to store and walk.
+//
+// Cells share their contents when copied: use Clone for an independent copy.
+type Cells struct {
+	board Board
+	cells []Cell
+}
+
+// NewCells returns the Cells of a Board with nothing placed or revealed.
+func NewCells(b Board) Cells {
+	return Cells{board: b, cells: make([]Cell, b.size())}
+}
+
+// Len returns the number of Cells.
+func (c Cells) Len() int { return len(c.cells) }
+
+// At returns the Cell at ref. Cells off the Board are always empty.
+func (c Cells) At(ref CellRef) Cell {
+	if !c.board.Contains(ref) {
+		return Cell{}
+	}
+	return c.cells[c.board.index(ref)]
+}
+
+// Set replaces the Cell at ref. Cells off the Board are left alone.
+func (c Cells) Set(ref CellRef, cell Cell) {
+	if c.board.Contains(ref) {
+		c.cells[c.board.index(ref)] = cell
+	}
+}
+
+// Nth returns the i'th Cell.
+func (c Cells) Nth(i int) Cell { return c.cells[i] }
+
+// Ref returns the CellRef of the i'th Cell.
+func (c Cells) Ref(i int) CellRef { return c.board.ref(i) }
+
+// IndexOf returns i such that the Cell at ref is the i'th Cell, or -1 if ref
+// is off the Board.
+func (c Cells) IndexOf(ref CellRef) int {
+	if !c.board.Contains(ref) {
+		return -1
+	}
+	return c.board.index(ref)
+}
+
+// Each calls f with every Cell, in order.
+func (c Cells) Each(f func(ref CellRef, cell Cell)) {
+	for i, cell := range c.cells {
+		f(c.board.ref(i), cell)
+	}
+}
+
+// Clone returns a copy of the Cells that can be changed independently.
+func (c Cells) Clone() Cells {
+	return Cells{board: c.board, cells: slices.Clone(c.cells)}
+}
+
+// Equal reports whether both hold the same Cells in the same places.
+func (c Cells) Equal(other Cells) bool {
+	return c.board.Width == other.board.Width &&
+		c.board.Height == other.board.Height &&
+		slices.Equal(c.cells, other.cells)
+}
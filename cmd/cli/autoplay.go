@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	randv2 "math/rand/v2"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/nightmarlin/sweeper"
+	"github.com/nightmarlin/sweeper/bot"
+	sweeperv1 "github.com/nightmarlin/sweeper/gen/sweeper/v1"
+	"github.com/nightmarlin/sweeper/infra/memory"
+)
+
+// botPlayer plays autoplayed games when no -player is given.
+const botPlayer = "bot"
+
+// autoplay has the reference bot play games on each of the boards, given as
+// height, width and mine count triples, and writes how it did. The games are
+// played on the server, or in-process if local is set.
+func (c client) autoplay(ctx context.Context, out output, local bool, games string, boards []string) error {
+	n, err := strconv.Atoi(games)
+	if err != nil {
+		return fmt.Errorf("parsing game count: %w", err)
+	}
+	if n < 1 {
+		return fmt.Errorf("game count must be at least 1, got %d", n)
+	}
+
+	if len(boards) == 0 || len(boards)%3 != 0 {
+		return fmt.Errorf("want boards as height, width and mine triples, got %d values", len(boards))
+	}
+
+	presets := make([]bot.Preset, 0, len(boards)/3)
+	for i := 0; i < len(boards); i += 3 {
+		b, err := parseBoard(boards[i], boards[i+1], boards[i+2], "")
+		if err != nil {
+			return err
+		}
+		presets = append(presets, bot.Preset{
+			Name:  fmt.Sprintf("%sx%s/%s", boards[i], boards[i+1], boards[i+2]),
+			Board: sweeperv1.BoardToInternalBoard(b),
+		})
+	}
+
+	player := c.player
+	if player == "" {
+		player = botPlayer
+	}
+	client := bot.ConnectClient(c.c, player)
+	if local {
+		svc := sweeper.NewService(memory.NewStore(), uuid.New, randv2.IntN, sweeper.SystemClock{})
+		client = bot.ServiceClient(svc, sweeper.PlayerID(player))
+	}
+
+	res, err := bot.Runner{Client: client, Player: bot.Logic{}, Games: n}.Run(ctx, presets...)
+	if err != nil {
+		return err
+	}
+	return writeAutoplay(out, res)
+}
+
+// writeAutoplay writes the results of autoplayed games, one preset at a time.
+func writeAutoplay(out output, res []bot.Result) error {
+	list := make([]any, 0, len(res))
+	for _, r := range res {
+		list = append(list, map[string]any{
+			"preset":    r.Preset.Name,
+			"games":     r.Games,
+			"wins":      r.Wins,
+			"resigned":  r.Resigned,
+			"moves":     r.Moves,
+			"win_rate":  r.WinRate(),
+			"mean_game": r.MeanGame().String(),
+			"mean_move": r.MeanMove().String(),
+			"fastest":   r.Fastest.String(),
+			"slowest":   r.Slowest.String(),
+		})
+	}
+	msg, err := structpb.NewStruct(map[string]any{"results": list})
+	if err != nil {
+		return err
+	}
+	return out.write(msg, func(w io.Writer) error { return renderAutoplay(w, res) })
+}
+
+func renderAutoplay(w io.Writer, res []bot.Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	if _, err := fmt.Fprintln(tw, "Preset\tGames\tWon\tResigned\tWin rate\tMean game\tMean move\tFastest win\t"); err != nil {
+		return err
+	}
+	for _, r := range res {
+		if _, err := fmt.Fprintf(
+			tw, "%s\t%d\t%d\t%d\t%.1f%%\t%s\t%s\t%s\t\n",
+			r.Preset.Name, r.Games, r.Wins, r.Resigned, 100*r.WinRate(),
+			r.MeanGame().Round(time.Microsecond), r.MeanMove().Round(time.Microsecond), r.Fastest.Round(time.Microsecond),
+		); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
@@ -2,25 +2,76 @@
 //
 // Usage
 //
-//	cli [-host=<host>] [-port=<port>] start <height> <width> <mines>
-//	cli [-host=<host>] [-port=<port>] view <game-id>
-//	cli [-host=<host>] [-port=<port>] play <game-id> <reset|flag|question|reveal> <row> <col>
-//	cli [-host=<host>] [-port=<port>] end <game-id>
+//	cli [-host=<host>] [-port=<port>] [-player=<id>] [-topology=<square|hex|triangle>] [-wrap] [-multimines=<n>] [-depth=<n>] [-rules=<classic|flags>] start <height> <width> <mines> [time-limit]
+//	cli [-host=<host>] [-port=<port>] [-player=<id>] [-topology=<square|hex|triangle>] [-wrap] [-multimines=<n>] [-rules=<classic|flags>] -layout=<file> start [time-limit]
+//	cli [-host=<host>] [-port=<port>] [-rows=<first>[-<last>]] [-cols=<first>[-<last>]] view [game]
+//	cli [-host=<host>] [-port=<port>] export <game> [file]
+//	cli [-host=<host>] [-port=<port>] replay <game> [file]
+//	cli [-host=<host>] [-port=<port>] [-player=<id>] [-rules=<classic|flags>] import <file> [time-limit]
+//	cli [-host=<host>] [-port=<port>] spectate [game]
+//	cli [-host=<host>] [-port=<port>] [-player=<id>] play [game] <reset|flag|question|reveal|chord> [layer] <row> <col>
+//	cli [-host=<host>] [-port=<port>] [-player=<id>] tui [game]
+//	cli [-host=<host>] [-port=<port>] [-player=<id>] end [game]
+//	cli [-host=<host>] [-port=<port>] -player=<id> invite [game] <invitee-id>
+//	cli [-host=<host>] [-port=<port>] -player=<id> join <game>
+//	cli [-host=<host>] [-port=<port>] [-topology=<square|hex|triangle>] [-wrap] [-multimines=<n>] [-depth=<n>] versus <height> <width> <mines> <player-id> <player-id>...
+//	cli [-host=<host>] [-port=<port>] match <match-id>
+//	cli [-host=<host>] [-port=<port>] [-player=<id>] [-best-of=<n>] [-topology=<square|hex|triangle>] [-wrap] [-multimines=<n>] [-depth=<n>] tournament create <best-of|fastest> <height> <width> <mines> <player-id> <player-id>...
+//	cli [-host=<host>] [-port=<port>] tournament view <tournament-id>
+//	cli [-host=<host>] [-port=<port>] -player=<id> [-topology=<square|hex|triangle>] [-wrap] [-multimines=<n>] [-depth=<n>] [-rules=<classic|flags>] queue <versus|coop> <players> <height> <width> <mines>
+//	cli [-host=<host>] [-port=<port>] use <game>
+//	cli [-host=<host>] [-port=<port>] games
+//	cli [-host=<host>] [-port=<port>] alias <name> [game]
+//	cli [-host=<host>] [-port=<port>] [-player=<id>] [-local] autoplay <games> <height> <width> <mines> [<height> <width> <mines>]...
+//
+// Games may be given by id, or by the alias of a recently seen game, listed by games. Each game the CLI is given
+// becomes the current game, which is used when the game is left out or given as ".". Recent games are kept in
+// $XDG_STATE_HOME/sweeper/games.json, or the file given with -games.
+//
+// Flags are read up to the command, so must come before it: -rows and -cols only apply to view when given before
+// view, not after it.
+//
+// view shows the whole board, or only the rows and columns given, in every layer.
+//
+// autoplay has the reference bot from package bot play the given number of games on each board, and reports its win
+// rate and timings. With -local, the games are played in-process rather than on the server.
+//
+// Default values for any flag can be set in $XDG_CONFIG_HOME/sweeper/config.json, or the file given with -config. It
+// holds the defaults, and profiles of values that take precedence over them, chosen with -profile:
+//
+//	{"defaults": {"player": "alice"}, "profile": "local", "profiles": {"local": {"host": "http://localhost"}}}
+//
+// Servers that authenticate players require -token, holding the bearer token the server was given for -player. They
+// refuse requests made as any other player, which is what keeps players from seeing their opponents' games, or
+// skipping the spectate delay. It is best kept in the config file.
+//
+// Every command also accepts [-theme=<default|ascii|name>] [-themes=<file>] [-colour=<auto|always|never>]
+// and [-output=<board|json|yaml|proto-text>]. Formats other than board write the server's messages, and report errors
+// as messages on stderr. The command exits with status 1 if it fails, or 2 if it was called incorrectly.
 package main
 
 import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
 
+	"github.com/nightmarlin/sweeper"
+	"github.com/nightmarlin/sweeper/boardtext"
 	sweeperv1 "github.com/nightmarlin/sweeper/gen/sweeper/v1"
 	"github.com/nightmarlin/sweeper/gen/sweeper/v1/sweeperv1connect"
 )
@@ -28,78 +79,666 @@ import (
 var (
 	host = flag.String("host", "http://localhost", "server hostname or ip address")
 	port = flag.String("port", "34567", "server port")
+
+	player = flag.String("player", "", "id of the player making requests")
+	token  = flag.String("token", "", "bearer token authenticating the player, for servers that require one")
+
+	topology   = flag.String("topology", "square", "topology of new boards: square, hex or triangle")
+	wrap       = flag.Bool("wrap", false, "join opposite edges of new boards together")
+	multimines = flag.Int("multimines", 1, "most mines a single cell of a new board may hold")
+	depth      = flag.Int("depth", 1, "number of layers in new boards")
+	rules      = flag.String("rules", "classic", "rules of new games: classic, or flags for turn-based play")
+
+	layout = flag.String("layout", "", "file holding the mine layout of a new game, one row per line: * for mines, . for safe cells, o for the cell to start from")
+
+	bestOf = flag.Int("best-of", 3, "number of heats each pairing in a best-of tournament may play")
+
+	themeName  = flag.String("theme", "default", "theme to draw boards with: default, ascii, or one from the themes file")
+	themesPath = flag.String("themes", defaultThemesPath(), "file holding user-defined themes")
+	colour     = flag.String("colour", "auto", "when to colour output: auto, always or never. auto uses colour on terminals, unless NO_COLOR is set")
+
+	outputFormat = flag.String("output", "board", "format to write results in: board, json, yaml or proto-text")
+
+	configPath = flag.String("config", defaultConfigPath(), "file holding default flag values and profiles")
+	profile    = flag.String("profile", "", "profile in the config file to take flag values from")
+	gamesPath  = flag.String("games", defaultGamesPath(), "file remembering recent games and their aliases")
+
+	local = flag.Bool("local", false, "play autoplayed games in-process instead of on the server")
+
+	rows = flag.String("rows", "", "rows of the board to view, as first-last or a single row, given before view. every row if unset")
+	cols = flag.String("cols", "", "columns of the board to view, as first-last or a single column, given before view. every column if unset")
 )
 
-func main() {
+func main() { os.Exit(run()) }
+
+// run runs the command, returning the code to exit with.
+func run() int {
 	flag.Parse()
+	// the config may choose the output format, so its errors are only reported
+	// once the output is made.
+	configErr := applyConfig(flag.CommandLine, *configPath, *profile)
+
+	out, err := newOutput(*outputFormat)
+	if err != nil {
+		slog.Error("invalid -output", slog.String("error", err.Error()))
+		return exitUsage
+	}
+	if configErr != nil {
+		out.error("failed to load config", configErr, exitUsage)
+		return exitUsage
+	}
+
 	var (
-		log         = slog.New(slog.NewTextHandler(os.Stderr, nil))
+		addr        = fmt.Sprintf("%s:%s", *host, *port)
 		ctx, cancel = signal.NotifyContext(context.Background(), os.Interrupt, os.Kill)
 		c           = client{
-			c: sweeperv1connect.NewSweeperServiceClient(
-				http.DefaultClient,
-				fmt.Sprintf("%s:%s", *host, *port),
-			),
+			c:      sweeperv1connect.NewSweeperServiceClient(httpClient(*token), addr),
+			player: *player,
 		}
 	)
 	defer cancel()
 
 	args := flag.Args()
 	if len(args) == 0 {
-		log.Error("at least one argument is required")
-		return
+		return out.usage("at least one argument is required")
+	}
+
+	coloured, err := useColour(*colour)
+	if err != nil {
+		return out.usage(fmt.Sprintf("invalid -colour: %v", err))
+	}
+	th, err := loadTheme(*themeName, *themesPath, coloured)
+	if err != nil {
+		return out.fail("failed to load theme", err)
 	}
 
+	reg, err := loadRegistry(*gamesPath)
+	if err != nil {
+		return out.fail("failed to load games", err)
+	}
+	games := reg.server(addr)
+
 	var (
-		g   *sweeperv1.Game
-		err error
+		g  *sweeperv1.Game
+		m  *sweeperv1.Match
+		t  *sweeperv1.Tournament
+		id string
 	)
 
 	switch args[0] {
 	case "start":
-		if len(args) != 4 {
-			log.Error("usage: start <height> <width> <mines>")
-			return
+		if *layout != "" {
+			if len(args) > 2 {
+				return out.usage("usage: -layout=<file> start [time-limit]")
+			}
+			var limit string
+			if len(args) == 2 {
+				limit = args[1]
+			}
+			g, err = c.startFromLayout(ctx, *layout, limit)
+			break
+		}
+
+		if len(args) != 4 && len(args) != 5 {
+			return out.usage("usage: start <height> <width> <mines> [time-limit]")
 		}
-		g, err = c.start(ctx, args[1], args[2], args[3])
+		var limit string
+		if len(args) == 5 {
+			limit = args[4]
+		}
+		g, err = c.start(ctx, args[1], args[2], args[3], limit)
 
 	case "view":
+		if len(args) > 2 {
+			return out.usage("usage: [-rows=<first>[-<last>]] [-cols=<first>[-<last>]] view [game]")
+		}
+		var region *sweeperv1.Region
+		if region, err = parseRegion(*rows, *cols); err != nil {
+			return out.usage(fmt.Sprintf("invalid region: %v", err))
+		}
+		if id, err = games.resolve(optionalArg(args, 1)); err == nil {
+			g, err = c.view(ctx, id, region)
+		}
+
+	case "use":
 		if len(args) != 2 {
-			log.Error("usage: view <game-id>")
-			return
+			return out.usage("usage: use <game>")
+		}
+		// the game becomes current once it has been seen.
+		if id, err = games.resolve(args[1]); err == nil {
+			g, err = c.view(ctx, id, nil)
 		}
-		g, err = c.view(ctx, args[1])
+
+	case "games":
+		if len(args) != 1 {
+			return out.usage("usage: games")
+		}
+		err = writeGames(out, games)
+
+	case "alias":
+		if len(args) != 2 && len(args) != 3 {
+			return out.usage("usage: alias <name> [game]")
+		}
+		if id, err = games.resolve(optionalArg(args, 2)); err == nil {
+			err = games.name(id, args[1])
+		}
+		if err == nil {
+			if err = reg.save(*gamesPath); err == nil {
+				err = writeGames(out, games)
+			}
+		}
+
+	case "export":
+		if len(args) != 2 && len(args) != 3 {
+			return out.usage("usage: export <game> [file]")
+		}
+		if id, err = games.resolve(args[1]); err == nil {
+			err = c.export(ctx, out, id, optionalArg(args, 2))
+		}
+
+	case "replay":
+		if len(args) != 2 && len(args) != 3 {
+			return out.usage("usage: replay <game> [file]")
+		}
+		if id, err = games.resolve(args[1]); err == nil {
+			err = c.replay(ctx, out, id, optionalArg(args, 2))
+		}
+
+	case "import":
+		if len(args) != 2 && len(args) != 3 {
+			return out.usage("usage: import <file> [time-limit]")
+		}
+		var limit string
+		if len(args) == 3 {
+			limit = args[2]
+		}
+		g, err = c.importBoard(ctx, args[1], limit)
+
+	case "spectate":
+		if len(args) > 2 {
+			return out.usage("usage: spectate [game]")
+		}
+		if id, err = games.resolve(optionalArg(args, 1)); err != nil {
+			break
+		}
+		// each update is rendered as it arrives, leaving nothing to render after.
+		stream := out.streaming()
+		err = c.spectate(ctx, id, func(g *sweeperv1.Game) error {
+			return stream.write(g, func(w io.Writer) error {
+				if err := renderGame(ctx, w, g, th); err != nil {
+					return fmt.Errorf("rendering game state: %w", err)
+				}
+				_, err := fmt.Fprintln(w)
+				return err
+			})
+		})
 
 	case "play":
-		if len(args) != 5 {
-			log.Error("usage: play <game-id> <command> <row> <column>")
-			return
+		// the game may be left out, as long as the command follows play directly.
+		if len(args) > 1 && isAction(args[1]) {
+			args = slices.Insert(args, 1, currentGame)
+		}
+		if len(args) != 5 && len(args) != 6 {
+			return out.usage("usage: play [game] <command> [layer] <row> <column>")
+		}
+		layer := "1"
+		if len(args) == 6 {
+			layer, args = args[3], slices.Delete(args, 3, 4)
+		}
+		if id, err = games.resolve(args[1]); err == nil {
+			g, err = c.play(ctx, id, args[2], layer, args[3], args[4])
+		}
+
+	case "tui":
+		if len(args) > 2 {
+			return out.usage("usage: tui [game]")
+		}
+		// the final state of the game is left on screen once the ui closes.
+		if id, err = games.resolve(optionalArg(args, 1)); err == nil {
+			g, err = c.tui(ctx, id, th)
 		}
-		g, err = c.play(ctx, args[1], args[2], args[3], args[4])
 
 	case "end":
+		if len(args) > 2 {
+			return out.usage("usage: end [game]")
+		}
+		if id, err = games.resolve(optionalArg(args, 1)); err == nil {
+			g, err = c.end(ctx, id)
+		}
+
+	case "invite":
+		if len(args) != 2 && len(args) != 3 {
+			return out.usage("usage: invite [game] <invitee-id>")
+		}
+		if len(args) == 2 {
+			args = slices.Insert(args, 1, currentGame)
+		}
+		if id, err = games.resolve(args[1]); err == nil {
+			g, err = c.invite(ctx, id, args[2])
+		}
+
+	case "join":
 		if len(args) != 2 {
-			log.Error("usage: end <game-id>")
-			return
+			return out.usage("usage: join <game>")
+		}
+		if id, err = games.resolve(args[1]); err == nil {
+			g, err = c.join(ctx, id)
 		}
-		g, err = c.end(ctx, args[1])
+
+	case "versus":
+		if len(args) < 6 {
+			return out.usage("usage: versus <height> <width> <mines> <player-id> <player-id>...")
+		}
+		m, err = c.versus(ctx, args[1], args[2], args[3], args[4:])
+
+	case "match":
+		if len(args) != 2 {
+			return out.usage("usage: match <match-id>")
+		}
+		m, err = c.match(ctx, args[1])
+
+	case "tournament":
+		switch {
+		case len(args) >= 8 && args[1] == "create":
+			t, err = c.createTournament(ctx, args[2], args[3], args[4], args[5], args[6:])
+		case len(args) == 3 && args[1] == "view":
+			t, err = c.tournament(ctx, args[2])
+		default:
+			return out.usage("usage: tournament create <best-of|fastest> <height> <width> <mines> <player-id> <player-id>... | tournament view <tournament-id>")
+		}
+
+	case "queue":
+		if len(args) != 6 {
+			return out.usage("usage: queue <versus|coop> <players> <height> <width> <mines>")
+		}
+		g, m, err = c.queue(ctx, out.log, args[1], args[2], args[3], args[4], args[5])
+
+	case "autoplay":
+		if len(args) < 5 || (len(args)-2)%3 != 0 {
+			return out.usage("usage: autoplay <games> <height> <width> <mines> [<height> <width> <mines>]...")
+		}
+		err = c.autoplay(ctx, out, *local, args[1], args[2:])
+
+	default:
+		return out.usage(fmt.Sprintf("unknown command: %s", args[0]))
 	}
 
 	if err != nil {
-		log.Error(fmt.Sprintf("failed to %s game", args[0]), slog.String("error", err.Error()))
-		return
+		return out.fail(fmt.Sprintf("failed to %s game", args[0]), err)
 	}
 
-	if err := renderGame(ctx, os.Stdout, g); err != nil {
-		log.Error("failed to render game state", slog.String("error", err.Error()))
+	// the game the player was last given becomes their current game.
+	var seen string
+	switch {
+	case g != nil:
+		seen = g.Id
+	case m != nil:
+		seen = playerGameID(m, c.player)
 	}
+	if seen != "" {
+		games.remember(seen)
+		if err := reg.save(*gamesPath); err != nil {
+			out.log.Warn("failed to save games", slog.String("error", err.Error()))
+		}
+	}
+
+	switch {
+	case t != nil:
+		err = out.write(t, func(w io.Writer) error { return renderTournament(ctx, w, t, th) })
+	case m != nil:
+		err = out.write(m, func(w io.Writer) error { return renderMatch(ctx, w, m, th) })
+	case g != nil:
+		err = out.write(g, func(w io.Writer) error { return renderGame(ctx, w, g, th) })
+	}
+	if err != nil {
+		return out.fail("failed to write output", err)
+	}
+	return exitOK
+}
+
+// optionalArg returns the argument at i, or the empty string if there isn't
+// one.
+func optionalArg(args []string, i int) string {
+	if i < len(args) {
+		return args[i]
+	}
+	return ""
+}
+
+// playerGameID returns the id of the player's game in the match, if they are
+// playing in it.
+func playerGameID(m *sweeperv1.Match, player string) string {
+	for _, p := range m.Players {
+		if player != "" && p.PlayerId == player {
+			return p.GameId
+		}
+	}
+	return ""
+}
+
+// writeGames writes the recently seen games, most recent first.
+func writeGames(out output, games *serverGames) error {
+	list := make([]any, 0, len(games.Games))
+	for _, g := range games.Games {
+		list = append(list, map[string]any{
+			"alias":   g.Alias,
+			"id":      g.ID,
+			"current": g.ID == games.Current,
+		})
+	}
+	msg, err := structpb.NewStruct(map[string]any{"games": list})
+	if err != nil {
+		return err
+	}
+	return out.write(msg, func(w io.Writer) error { return renderGames(w, games) })
+}
+
+// httpClient returns the client requests are sent with, which sends the bearer
+// token with each of them if it is set.
+func httpClient(token string) *http.Client {
+	if token == "" {
+		return http.DefaultClient
+	}
+	return &http.Client{Transport: bearer{token: token, next: http.DefaultTransport}}
+}
+
+// bearer is an http.RoundTripper that authenticates requests with a token.
+type bearer struct {
+	token string
+	next  http.RoundTripper
+}
+
+func (b bearer) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+b.token)
+	return b.next.RoundTrip(req)
 }
 
 type client struct {
-	c sweeperv1connect.SweeperServiceClient
+	c      sweeperv1connect.SweeperServiceClient
+	player string
+}
+
+func (c client) start(ctx context.Context, h, w, m, limit string) (*sweeperv1.Game, error) {
+	board, err := parseBoard(h, w, m, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.c.StartGame(
+		ctx,
+		&connect.Request[sweeperv1.StartGameRequest]{
+			Msg: &sweeperv1.StartGameRequest{Board: board, PlayerId: c.player},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg.Game, nil
+}
+
+func (c client) startFromLayout(ctx context.Context, path, limit string) (*sweeperv1.Game, error) {
+	grid, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading layout: %w", err)
+	}
+
+	board, err := newBoard(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.c.StartGameFromLayout(
+		ctx,
+		&connect.Request[sweeperv1.StartGameFromLayoutRequest]{
+			Msg: &sweeperv1.StartGameFromLayoutRequest{
+				PlayerId: c.player,
+				Board:    board,
+				Layout:   &sweeperv1.StartGameFromLayoutRequest_Grid{Grid: string(grid)},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg.Game, nil
 }
 
-func (c client) start(ctx context.Context, h, w, m string) (*sweeperv1.Game, error) {
+// export writes the game's board to the file, or the output if no file is
+// given.
+func (c client) export(ctx context.Context, out output, id, path string) error {
+	res, err := c.c.ExportGame(
+		ctx,
+		&connect.Request[sweeperv1.ExportGameRequest]{
+			Msg: &sweeperv1.ExportGameRequest{GameId: id, PlayerId: c.player},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return writeOut(out, path, res.Msg, res.Msg.Board)
+}
+
+// replay writes the game's replay to the file, or the output if no file is
+// given.
+func (c client) replay(ctx context.Context, out output, id, path string) error {
+	res, err := c.c.ExportReplay(
+		ctx,
+		&connect.Request[sweeperv1.ExportReplayRequest]{
+			Msg: &sweeperv1.ExportReplayRequest{GameId: id, PlayerId: c.player},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return writeOut(out, path, res.Msg, res.Msg.Replay)
+}
+
+// writeOut writes s to the file at path. If path is empty, it writes s as the
+// board format to the output, or the message holding it for other formats.
+func writeOut(out output, path string, m proto.Message, s string) error {
+	if path == "" {
+		return out.write(m, func(w io.Writer) error {
+			_, err := fmt.Fprint(w, s)
+			return err
+		})
+	}
+	return os.WriteFile(path, []byte(s), 0o644)
+}
+
+// importBoard starts a game from the board in the file.
+func (c client) importBoard(ctx context.Context, path, limit string) (*sweeperv1.Game, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening board: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	b, err := boardtext.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading board: %w", err)
+	}
+	internalBoard, layout, err := b.Layout()
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := newBoard(limit)
+	if err != nil {
+		return nil, err
+	}
+	// the text format only describes flat square boards.
+	board.Height, board.Width = int32(internalBoard.Height), int32(internalBoard.Width)
+	board.Topology, board.Wrap, board.MaxMinesPerCell, board.Depth = sweeperv1.Topology_SQUARE, false, 1, 0
+
+	mines := &sweeperv1.MineLayout{}
+	for _, ref := range layout.Mines {
+		mines.Mines = append(mines.Mines, &sweeperv1.CellRef{Row: int32(ref.Row), Column: int32(ref.Column)})
+	}
+
+	res, err := c.c.StartGameFromLayout(
+		ctx,
+		&connect.Request[sweeperv1.StartGameFromLayoutRequest]{
+			Msg: &sweeperv1.StartGameFromLayoutRequest{
+				PlayerId: c.player,
+				Board:    board,
+				Layout:   &sweeperv1.StartGameFromLayoutRequest_Mines{Mines: mines},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg.Game, nil
+}
+
+func (c client) versus(ctx context.Context, h, w, m string, players []string) (*sweeperv1.Match, error) {
+	board, err := parseBoard(h, w, m, "")
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.c.StartMatch(
+		ctx,
+		&connect.Request[sweeperv1.StartMatchRequest]{
+			Msg: &sweeperv1.StartMatchRequest{Board: board, PlayerIds: players},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg.Match, nil
+}
+
+func (c client) match(ctx context.Context, id string) (*sweeperv1.Match, error) {
+	res, err := c.c.GetMatch(
+		ctx,
+		&connect.Request[sweeperv1.GetMatchRequest]{
+			Msg: &sweeperv1.GetMatchRequest{MatchId: id, PlayerId: c.player},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg.Match, nil
+}
+
+func (c client) createTournament(
+	ctx context.Context,
+	format, h, w, m string,
+	players []string,
+) (*sweeperv1.Tournament, error) {
+	var f sweeperv1.TournamentFormat
+	switch format {
+	case "best-of":
+		f = sweeperv1.TournamentFormat_BEST_OF
+	case "fastest":
+		f = sweeperv1.TournamentFormat_FASTEST_TIME
+	default:
+		return nil, fmt.Errorf("unknown format: %s", format)
+	}
+
+	board, err := parseBoard(h, w, m, "")
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.c.CreateTournament(
+		ctx,
+		&connect.Request[sweeperv1.CreateTournamentRequest]{
+			Msg: &sweeperv1.CreateTournamentRequest{
+				OrganiserId: c.player,
+				Board:       board,
+				Format:      f,
+				BestOf:      int32(*bestOf),
+				PlayerIds:   players,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg.Tournament, nil
+}
+
+func (c client) tournament(ctx context.Context, id string) (*sweeperv1.Tournament, error) {
+	res, err := c.c.GetTournament(
+		ctx,
+		&connect.Request[sweeperv1.GetTournamentRequest]{
+			Msg: &sweeperv1.GetTournamentRequest{TournamentId: id},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg.Tournament, nil
+}
+
+// queue waits in the lobby until paired, returning the match for versus games
+// or the shared game for co-op games.
+func (c client) queue(
+	ctx context.Context,
+	log *slog.Logger,
+	mode, players, h, w, m string,
+) (*sweeperv1.Game, *sweeperv1.Match, error) {
+	var lm sweeperv1.LobbyMode
+	switch mode {
+	case "versus":
+		lm = sweeperv1.LobbyMode_VERSUS
+	case "coop":
+		lm = sweeperv1.LobbyMode_COOP
+	default:
+		return nil, nil, fmt.Errorf("unknown mode: %s", mode)
+	}
+
+	pInt, err := strconv.ParseInt(players, 10, 32)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing player count: %w", err)
+	}
+
+	board, err := parseBoard(h, w, m, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream, err := c.c.Queue(
+		ctx,
+		&connect.Request[sweeperv1.QueueRequest]{
+			Msg: &sweeperv1.QueueRequest{
+				PlayerId: c.player,
+				Preset:   &sweeperv1.Preset{Board: board, Mode: lm, Players: int32(pInt)},
+			},
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = stream.Close() }()
+
+	for stream.Receive() {
+		switch s := stream.Msg().Status.(type) {
+		case *sweeperv1.QueueResponse_Queued:
+			log.Info("waiting for players", slog.Int("waiting", int(s.Queued.PlayersWaiting)))
+
+		case *sweeperv1.QueueResponse_Paired:
+			if s.Paired.MatchId != "" {
+				match, err := c.match(ctx, s.Paired.MatchId)
+				return nil, match, err
+			}
+			g, err := c.view(ctx, s.Paired.GameId, nil)
+			return g, nil, err
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return nil, nil, err
+	}
+	return nil, nil, fmt.Errorf("queue closed before pairing")
+}
+
+// parseBoard parses a Board from its dimensions and time limit, using the
+// global flags to fill in the rest.
+func parseBoard(h, w, m, limit string) (*sweeperv1.Board, error) {
 	hInt, err := strconv.ParseInt(h, 10, 32)
 	if err != nil {
 		return nil, fmt.Errorf("parsing height: %w", err)
@@ -113,29 +752,135 @@ func (c client) start(ctx context.Context, h, w, m string) (*sweeperv1.Game, err
 		return nil, fmt.Errorf("parsing mine count: %w", err)
 	}
 
-	res, err := c.c.StartGame(
-		ctx,
-		&connect.Request[sweeperv1.StartGameRequest]{
-			Msg: &sweeperv1.StartGameRequest{
-				Board: &sweeperv1.Board{
-					Height: int32(hInt),
-					Width:  int32(wInt),
-					Mines:  int32(mInt),
-				},
-			},
-		},
-	)
+	board, err := newBoard(limit)
 	if err != nil {
 		return nil, err
 	}
-	return res.Msg.Game, nil
+	board.Height, board.Width, board.Mines = int32(hInt), int32(wInt), int32(mInt)
+	return board, nil
+}
+
+// newBoard creates a Board from the global flags and the time limit, leaving
+// its dimensions unset.
+func newBoard(limit string) (*sweeperv1.Board, error) {
+	t, err := parseTopology(*topology)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := parseRules(*rules)
+	if err != nil {
+		return nil, err
+	}
+
+	board := &sweeperv1.Board{
+		Topology:        t,
+		Wrap:            *wrap,
+		MaxMinesPerCell: int32(*multimines),
+		Depth:           int32(*depth),
+		Rules:           r,
+	}
+	if limit != "" {
+		d, err := time.ParseDuration(limit)
+		if err != nil {
+			return nil, fmt.Errorf("parsing time limit: %w", err)
+		}
+		board.TimeLimit = durationpb.New(d)
+	}
+	return board, nil
 }
 
-func (c client) view(ctx context.Context, id string) (*sweeperv1.Game, error) {
+// parseRegion parses the -rows and -cols ranges into a Region, or nil if
+// neither is set. A range left unset spans as much of the board as the server
+// will return.
+func parseRegion(rows, cols string) (*sweeperv1.Region, error) {
+	if rows == "" && cols == "" {
+		return nil, nil
+	}
+
+	top, height, err := parseRange(rows)
+	if err != nil {
+		return nil, fmt.Errorf("parsing rows: %w", err)
+	}
+	left, width, err := parseRange(cols)
+	if err != nil {
+		return nil, fmt.Errorf("parsing columns: %w", err)
+	}
+
+	switch {
+	case height > sweeper.MaxRegionCells || width > sweeper.MaxRegionCells:
+		return nil, fmt.Errorf("regions may hold at most %d cells", sweeper.MaxRegionCells)
+	case height == 0:
+		height = sweeper.MaxRegionCells / width
+	case width == 0:
+		width = sweeper.MaxRegionCells / height
+	case height > sweeper.MaxRegionCells/width:
+		return nil, fmt.Errorf("%dx%d region holds more than %d cells", height, width, sweeper.MaxRegionCells)
+	}
+	return &sweeperv1.Region{
+		Top:     int32(top),
+		Left:    int32(left),
+		Rows:    int32(height),
+		Columns: int32(width),
+	}, nil
+}
+
+// parseRange parses a range of rows or columns numbered from 1, given as
+// first-last or a single number, into the index of the first and how many
+// there are. It returns zeroes for an empty range.
+func parseRange(s string) (first, n int, err error) {
+	if s == "" {
+		return 0, 0, nil
+	}
+
+	from, to, ok := strings.Cut(s, "-")
+	if !ok {
+		to = from
+	}
+	f, err := strconv.Atoi(from)
+	if err != nil {
+		return 0, 0, fmt.Errorf("parsing start: %w", err)
+	}
+	l, err := strconv.Atoi(to)
+	if err != nil {
+		return 0, 0, fmt.Errorf("parsing end: %w", err)
+	}
+	if f < 1 || l < f {
+		return 0, 0, fmt.Errorf("invalid range: %s", s)
+	}
+	return f - 1, l - f + 1, nil
+}
+
+func parseTopology(t string) (sweeperv1.Topology, error) {
+	switch t {
+	case "square":
+		return sweeperv1.Topology_SQUARE, nil
+	case "hex":
+		return sweeperv1.Topology_HEX, nil
+	case "triangle":
+		return sweeperv1.Topology_TRIANGLE, nil
+	default:
+		return sweeperv1.Topology_TOPOLOGY_UNKNOWN, fmt.Errorf("unknown topology: %s", t)
+	}
+}
+
+func parseRules(r string) (sweeperv1.Rules, error) {
+	switch r {
+	case "classic":
+		return sweeperv1.Rules_CLASSIC, nil
+	case "flags":
+		return sweeperv1.Rules_MINE_FLAGS, nil
+	default:
+		return sweeperv1.Rules_RULES_UNKNOWN, fmt.Errorf("unknown rules: %s", r)
+	}
+}
+
+// view gets the game, holding only the cells in the region if there is one.
+func (c client) view(ctx context.Context, id string, region *sweeperv1.Region) (*sweeperv1.Game, error) {
 	res, err := c.c.GetGame(
 		ctx,
 		&connect.Request[sweeperv1.GetGameRequest]{
-			Msg: &sweeperv1.GetGameRequest{GameId: id},
+			Msg: &sweeperv1.GetGameRequest{GameId: id, PlayerId: c.player, Region: region},
 		},
 	)
 	if err != nil {
@@ -144,13 +889,52 @@ func (c client) view(ctx context.Context, id string) (*sweeperv1.Game, error) {
 	return res.Msg.Game, nil
 }
 
+// spectate calls render with each update to the game until it finishes.
+func (c client) spectate(ctx context.Context, id string, render func(g *sweeperv1.Game) error) error {
+	stream, err := c.c.Spectate(
+		ctx,
+		&connect.Request[sweeperv1.SpectateRequest]{
+			Msg: &sweeperv1.SpectateRequest{GameId: id},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = stream.Close() }()
+
+	for stream.Receive() {
+		if err := render(stream.Msg().Game); err != nil {
+			return err
+		}
+	}
+	return stream.Err()
+}
+
 func (c client) end(ctx context.Context, id string) (*sweeperv1.Game, error) {
 	res, err := c.c.MakeMove(
 		ctx,
 		&connect.Request[sweeperv1.MakeMoveRequest]{
 			Msg: &sweeperv1.MakeMoveRequest{
-				GameId: id,
-				Move:   &sweeperv1.MakeMoveRequest_End{End: &emptypb.Empty{}},
+				GameId:   id,
+				PlayerId: c.player,
+				Move:     &sweeperv1.MakeMoveRequest_End{End: &emptypb.Empty{}},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg.Game, nil
+}
+
+func (c client) invite(ctx context.Context, id, invitee string) (*sweeperv1.Game, error) {
+	res, err := c.c.InvitePlayer(
+		ctx,
+		&connect.Request[sweeperv1.InvitePlayerRequest]{
+			Msg: &sweeperv1.InvitePlayerRequest{
+				GameId:    id,
+				PlayerId:  c.player,
+				InviteeId: invitee,
 			},
 		},
 	)
@@ -160,12 +944,50 @@ func (c client) end(ctx context.Context, id string) (*sweeperv1.Game, error) {
 	return res.Msg.Game, nil
 }
 
+func (c client) join(ctx context.Context, id string) (*sweeperv1.Game, error) {
+	res, err := c.c.JoinGame(
+		ctx,
+		&connect.Request[sweeperv1.JoinGameRequest]{
+			Msg: &sweeperv1.JoinGameRequest{GameId: id, PlayerId: c.player},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg.Game, nil
+}
+
+// actions maps the commands accepted by play to the moves they make.
+var actions = map[string]sweeperv1.CellMoveAction{
+	"clear":    sweeperv1.CellMoveAction_CLEAR,
+	"c":        sweeperv1.CellMoveAction_CLEAR,
+	"reset":    sweeperv1.CellMoveAction_CLEAR,
+	"flag":     sweeperv1.CellMoveAction_FLAG,
+	"f":        sweeperv1.CellMoveAction_FLAG,
+	"question": sweeperv1.CellMoveAction_QUESTION,
+	"q":        sweeperv1.CellMoveAction_QUESTION,
+	"reveal":   sweeperv1.CellMoveAction_REVEAL,
+	"r":        sweeperv1.CellMoveAction_REVEAL,
+	"chord":    sweeperv1.CellMoveAction_CHORD,
+}
+
+func isAction(s string) bool {
+	_, ok := actions[s]
+	return ok
+}
+
 func (c client) play(
 	ctx context.Context,
 	id string,
 	action string,
-	row, col string,
+	layer, row, col string,
 ) (*sweeperv1.Game, error) {
+	lInt, err := strconv.ParseInt(layer, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("parsing layer: %w", err)
+	}
+	lInt -= 1
+
 	rInt, err := strconv.ParseInt(row, 10, 32)
 	if err != nil {
 		return nil, fmt.Errorf("parsing row: %w", err)
@@ -178,29 +1000,36 @@ func (c client) play(
 	}
 	cInt -= 1
 
-	var a sweeperv1.CellMoveAction
-	switch action {
-	case "clear", "c", "reset":
-		a = sweeperv1.CellMoveAction_CLEAR
-	case "flag", "f":
-		a = sweeperv1.CellMoveAction_FLAG
-	case "question", "q":
-		a = sweeperv1.CellMoveAction_QUESTION
-	case "reveal", "r":
-		a = sweeperv1.CellMoveAction_REVEAL
-	default:
+	a, ok := actions[action]
+	if !ok {
 		return nil, fmt.Errorf("unknown action: %s", action)
 	}
 
+	return c.move(
+		ctx,
+		id,
+		&sweeperv1.CellRef{Layer: int32(lInt), Row: int32(rInt), Column: int32(cInt)},
+		a,
+	)
+}
+
+func (c client) move(
+	ctx context.Context,
+	id string,
+	ref *sweeperv1.CellRef,
+	a sweeperv1.CellMoveAction,
+) (*sweeperv1.Game, error) {
 	res, err := c.c.MakeMove(
 		ctx,
 		&connect.Request[sweeperv1.MakeMoveRequest]{
 			Msg: &sweeperv1.MakeMoveRequest{
-				GameId: id,
+				GameId:   id,
+				PlayerId: c.player,
 				Move: &sweeperv1.MakeMoveRequest_Cell{
 					Cell: &sweeperv1.CellMove{
-						Row:    int32(rInt),
-						Column: int32(cInt),
+						Layer:  ref.Layer,
+						Row:    ref.Row,
+						Column: ref.Column,
 						Action: a,
 					},
 				},
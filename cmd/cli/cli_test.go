@@ -0,0 +1,52 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/nightmarlin/sweeper"
+)
+
+func TestParseRegion(t *testing.T) {
+	t.Parallel()
+
+	most := strconv.Itoa(sweeper.MaxRegionCells)
+	for name, tc := range map[string]struct {
+		rows, cols string
+		want       sweeper.Region
+		wantErr    bool
+	}{
+		"unset":            {want: sweeper.Region{}},
+		"rows and columns": {rows: "2-4", cols: "3", want: sweeper.Region{Top: 1, Left: 2, Rows: 3, Columns: 1}},
+		"only rows":        {rows: "1-256", want: sweeper.Region{Rows: 256, Columns: 256}},
+		"only columns":     {cols: "1", want: sweeper.Region{Rows: sweeper.MaxRegionCells, Columns: 1}},
+		"every row":        {rows: "1-" + most, want: sweeper.Region{Rows: sweeper.MaxRegionCells, Columns: 1}},
+		"too many rows":    {rows: "1-" + strconv.Itoa(sweeper.MaxRegionCells+1), wantErr: true},
+		"too many columns": {rows: "1", cols: "1-" + strconv.Itoa(sweeper.MaxRegionCells+1), wantErr: true},
+		"too many cells":   {rows: "1-257", cols: "1-256", wantErr: true},
+		"backwards":        {rows: "4-2", wantErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := parseRegion(tc.rows, tc.cols)
+			switch {
+			case tc.wantErr:
+				if err == nil {
+					t.Errorf("want error, got %v", r)
+				}
+			case err != nil:
+				t.Fatalf("parsing region: %v", err)
+			case r == nil:
+				if tc.want != (sweeper.Region{}) {
+					t.Errorf("want %+v, got nil", tc.want)
+				}
+			default:
+				got := sweeper.Region{Top: int(r.Top), Left: int(r.Left), Rows: int(r.Rows), Columns: int(r.Columns)}
+				if got != tc.want {
+					t.Errorf("want %+v, got %+v", tc.want, got)
+				}
+			}
+		})
+	}
+}
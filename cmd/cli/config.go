@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// config holds default values for the CLI's flags, read from the config file
+// by flag name:
+//
+//	{
+//	  "defaults": {"player": "alice", "theme": "ascii"},
+//	  "profile": "local",
+//	  "profiles": {
+//	    "local": {"host": "http://localhost", "port": "34567"},
+//	    "prod": {"host": "https://sweeper.example.com", "port": "443"}
+//	  }
+//	}
+//
+// Flags set on the command line take precedence over the profile, chosen with
+// -profile or by the file, which takes precedence over the defaults.
+type config struct {
+	Defaults map[string]string            `json:"defaults"`
+	Profile  string                       `json:"profile"`
+	Profiles map[string]map[string]string `json:"profiles"`
+}
+
+// configFlags are the flags that choose the config, so can't be set by it.
+var configFlags = []string{"config", "profile"}
+
+// defaultConfigPath returns where the config file is read from by default,
+// which honours XDG_CONFIG_HOME.
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "sweeper", "config.json")
+}
+
+// applyConfig sets each flag in flags that wasn't set on the command line from
+// the config file at path, using the named profile if there is one. The file
+// is optional unless a profile is named.
+func applyConfig(flags *flag.FlagSet, path, profile string) error {
+	var cfg config
+	b, err := os.ReadFile(path)
+	switch {
+	case path == "", errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return fmt.Errorf("reading config: %w", err)
+	default:
+		if err := json.Unmarshal(b, &cfg); err != nil {
+			return fmt.Errorf("%w: %w", errInvalidConfig, err)
+		}
+	}
+
+	if profile == "" {
+		profile = cfg.Profile
+	}
+	values, ok := cfg.Profiles[profile]
+	if profile != "" && !ok {
+		return fmt.Errorf("%w: %q", errUnknownProfile, profile)
+	}
+
+	set := map[string]bool{}
+	for _, name := range configFlags {
+		set[name] = true
+	}
+	flags.Visit(func(f *flag.Flag) { set[f.Name] = true })
+
+	// the profile is applied first, so the defaults can't override it.
+	for _, settings := range []map[string]string{values, cfg.Defaults} {
+		for name, value := range settings {
+			if flags.Lookup(name) == nil || slices.Contains(configFlags, name) {
+				return fmt.Errorf("%w: unknown flag %q", errInvalidConfig, name)
+			}
+			if set[name] {
+				continue
+			}
+			if err := flags.Set(name, value); err != nil {
+				return fmt.Errorf("%w: setting %s: %w", errInvalidConfig, name, err)
+			}
+			set[name] = true
+		}
+	}
+	return nil
+}
+
+var (
+	errInvalidConfig  = fmt.Errorf("invalid config")
+	errUnknownProfile = fmt.Errorf("unknown profile")
+)
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestApplyConfig(t *testing.T) {
+	t.Parallel()
+
+	const cfg = `{
+		"defaults": {"player": "alice", "theme": "ascii"},
+		"profile": "local",
+		"profiles": {
+			"local": {"host": "http://localhost", "port": "34567"},
+			"prod": {"host": "https://sweeper.example.com", "port": "443", "player": "bob"}
+		}
+	}`
+
+	for name, tc := range map[string]struct {
+		config  string // written to the config file, unless empty.
+		args    []string
+		want    map[string]string
+		wantErr error
+	}{
+		"no config file": {
+			want: map[string]string{"player": "", "theme": "default", "host": "http://localhost", "port": "8080"},
+		},
+		"defaults and the file's profile": {
+			config: cfg,
+			want:   map[string]string{"player": "alice", "theme": "ascii", "host": "http://localhost", "port": "34567"},
+		},
+		"profile overrides defaults": {
+			config: cfg,
+			args:   []string{"-profile", "prod"},
+			want:   map[string]string{"player": "bob", "theme": "ascii", "host": "https://sweeper.example.com", "port": "443"},
+		},
+		"flags override profile and defaults": {
+			config: cfg,
+			args:   []string{"-profile", "prod", "-player", "carol", "-port", "8443", "-theme", "default"},
+			want:   map[string]string{"player": "carol", "theme": "default", "host": "https://sweeper.example.com", "port": "8443"},
+		},
+		"unknown profile": {
+			config:  cfg,
+			args:    []string{"-profile", "staging"},
+			wantErr: errUnknownProfile,
+		},
+		"profile without config file": {
+			args:    []string{"-profile", "local"},
+			wantErr: errUnknownProfile,
+		},
+		"unknown key": {
+			config:  `{"defaults": {"colour": "never"}}`,
+			wantErr: errInvalidConfig,
+		},
+		"config flag in config": {
+			config:  `{"defaults": {"profile": "prod"}}`,
+			wantErr: errInvalidConfig,
+		},
+		"invalid value": {
+			config:  `{"defaults": {"verbose": "maybe"}}`,
+			wantErr: errInvalidConfig,
+		},
+		"invalid json": {
+			config:  `{"defaults": []}`,
+			wantErr: errInvalidConfig,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "config.json")
+			if tc.config != "" {
+				if err := os.WriteFile(path, []byte(tc.config), 0o600); err != nil {
+					t.Fatalf("writing config: %v", err)
+				}
+			}
+
+			flags := flag.NewFlagSet("sweeper", flag.ContinueOnError)
+			values := map[string]*string{
+				"player": flags.String("player", "", ""),
+				"theme":  flags.String("theme", "default", ""),
+				"host":   flags.String("host", "http://localhost", ""),
+				"port":   flags.String("port", "8080", ""),
+			}
+			flags.Bool("verbose", false, "")
+			flags.String("config", path, "")
+			profile := flags.String("profile", "", "")
+			if err := flags.Parse(tc.args); err != nil {
+				t.Fatalf("parsing flags: %v", err)
+			}
+
+			err := applyConfig(flags, path, *profile)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("want error %v, got %v", tc.wantErr, err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			got := map[string]string{}
+			for name, v := range values {
+				got[name] = *v
+			}
+			if !maps.Equal(got, tc.want) {
+				t.Errorf("want flags %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// maxSavedGames is how many recent games are remembered for each server.
+const maxSavedGames = 20
+
+// currentGame may be given in place of a game id to use the current game.
+const currentGame = "."
+
+// registry remembers the games the CLI has seen recently, so they can be
+// referred to by short aliases. Game ids only mean something to the server
+// they came from, so each server has its own games.
+type registry struct {
+	Servers map[string]*serverGames `json:"servers"`
+}
+
+type serverGames struct {
+	Current string      `json:"current"` // The id of the current game.
+	Next    int         `json:"next"`    // The number of the next generated alias.
+	Games   []savedGame `json:"games"`   // Most recently seen first.
+}
+
+type savedGame struct {
+	Alias string `json:"alias"`
+	ID    string `json:"id"`
+}
+
+// defaultGamesPath returns where the registry is kept by default, which
+// honours XDG_STATE_HOME.
+func defaultGamesPath() string {
+	dir := os.Getenv("XDG_STATE_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(home, ".local", "state")
+	}
+	return filepath.Join(dir, "sweeper", "games.json")
+}
+
+// loadRegistry reads the registry at path. It is empty if there isn't one yet.
+func loadRegistry(path string) (*registry, error) {
+	r := &registry{Servers: map[string]*serverGames{}}
+	if path == "" {
+		return r, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading games: %w", err)
+	}
+	if err := json.Unmarshal(b, r); err != nil {
+		return nil, fmt.Errorf("reading games: %w", err)
+	}
+	if r.Servers == nil {
+		r.Servers = map[string]*serverGames{}
+	}
+	return r, nil
+}
+
+// save writes the registry to path, replacing it in one go so that CLIs
+// running at the same time can't leave it half written.
+func (r *registry) save(path string) error {
+	if path == "" {
+		return nil
+	}
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), ".games-*.json")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// server returns the games seen on the server.
+func (r *registry) server(addr string) *serverGames {
+	s, ok := r.Servers[addr]
+	if !ok {
+		s = &serverGames{Next: 1}
+		r.Servers[addr] = s
+	}
+	return s
+}
+
+// resolve returns the id of the game ref refers to: the current game if ref is
+// empty or currentGame, the game with the alias, or otherwise ref itself.
+func (s *serverGames) resolve(ref string) (string, error) {
+	if ref == "" || ref == currentGame {
+		if s.Current == "" {
+			return "", errNoCurrentGame
+		}
+		return s.Current, nil
+	}
+	if i := slices.IndexFunc(s.Games, func(g savedGame) bool { return g.Alias == ref }); i >= 0 {
+		return s.Games[i].ID, nil
+	}
+	return ref, nil
+}
+
+// remember records that the game has been seen, making it the current game
+// and giving it an alias if it doesn't have one.
+func (s *serverGames) remember(id string) savedGame {
+	g := savedGame{ID: id}
+	if i := slices.IndexFunc(s.Games, func(g savedGame) bool { return g.ID == id }); i >= 0 {
+		g = s.Games[i]
+		s.Games = slices.Delete(s.Games, i, i+1)
+	} else {
+		g.Alias = s.nextAlias()
+	}
+
+	s.Games = slices.Insert(s.Games, 0, g)
+	if len(s.Games) > maxSavedGames {
+		s.Games = s.Games[:maxSavedGames]
+	}
+	s.Current = id
+	return g
+}
+
+// nextAlias generates an alias that no saved game has.
+func (s *serverGames) nextAlias() string {
+	for {
+		alias := fmt.Sprintf("g%d", max(s.Next, 1))
+		s.Next = max(s.Next, 1) + 1
+		if !slices.ContainsFunc(s.Games, func(g savedGame) bool { return g.Alias == alias }) {
+			return alias
+		}
+	}
+}
+
+// name gives the game the alias instead of its current one, remembering it.
+func (s *serverGames) name(id, alias string) error {
+	if err := validateAlias(alias); err != nil {
+		return err
+	}
+	if i := slices.IndexFunc(s.Games, func(g savedGame) bool { return g.Alias == alias }); i >= 0 && s.Games[i].ID != id {
+		return fmt.Errorf("%w: %q is already used by %s", errInvalidAlias, alias, s.Games[i].ID)
+	}
+
+	s.remember(id)
+	s.Games[0].Alias = alias
+	return nil
+}
+
+// validateAlias checks the alias could be told apart from the other arguments
+// it might be given in place of.
+func validateAlias(alias string) error {
+	switch {
+	case alias == "" || alias == currentGame:
+		return fmt.Errorf("%w: %q", errInvalidAlias, alias)
+	case strings.ContainsFunc(alias, unicode.IsSpace):
+		return fmt.Errorf("%w: %q contains whitespace", errInvalidAlias, alias)
+	case isAction(alias):
+		return fmt.Errorf("%w: %q is a play command", errInvalidAlias, alias)
+	}
+	return nil
+}
+
+var (
+	errNoCurrentGame = fmt.Errorf("no current game: pass a game id, or start, view or use one")
+	errInvalidAlias  = fmt.Errorf("invalid alias")
+)
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func Example_serverGames() {
+	games := (&registry{Servers: map[string]*serverGames{}}).server("http://localhost:34567")
+
+	games.remember("0_first_game")
+	games.remember("1_second_game")
+	if err := games.name("0_first_game", "first"); err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(games.name("1_second_game", "reveal"))
+
+	for _, ref := range []string{".", "first", "g2", "2_unseen_game"} {
+		id, _ := games.resolve(ref)
+		fmt.Printf("%s: %s\n", ref, id)
+	}
+	_ = renderGames(os.Stdout, games)
+
+	// Output: invalid alias: "reveal" is a play command
+	// .: 0_first_game
+	// first: 0_first_game
+	// g2: 1_second_game
+	// 2_unseen_game: 2_unseen_game
+	//    Alias  Game
+	// *  first  0_first_game
+	//    g2     1_second_game
+}
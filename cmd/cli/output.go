@@ -0,0 +1,147 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	outputBoard     = "board"
+	outputJSON      = "json"
+	outputYAML      = "yaml"
+	outputProtoText = "proto-text"
+)
+
+// Exit codes.
+const (
+	exitOK      = 0
+	exitFailure = 1 // The command failed.
+	exitUsage   = 2 // The command was called incorrectly.
+)
+
+// output writes the results of commands in the format chosen with -output.
+// Only the board format is meant for people: the rest write the messages
+// returned by the server, and report errors as messages too.
+type output struct {
+	format string
+	log    *slog.Logger
+	stream bool
+
+	stdout, stderr io.Writer
+}
+
+func newOutput(format string) (output, error) {
+	o := output{format: format, stdout: os.Stdout, stderr: os.Stderr}
+	switch format {
+	case outputBoard:
+		o.log = slog.New(slog.NewTextHandler(o.stderr, nil))
+	case outputJSON, outputYAML, outputProtoText:
+		o.log = slog.New(slog.NewJSONHandler(o.stderr, nil))
+	default:
+		return output{}, fmt.Errorf("unknown output format: %s", format)
+	}
+	return o, nil
+}
+
+// streaming returns a copy of the output for writing a stream of messages. JSON
+// is written one message per line, and YAML as one document per message.
+func (o output) streaming() output {
+	o.stream = true
+	return o
+}
+
+// write writes the message, or calls render to write it for the board format.
+func (o output) write(m proto.Message, render func(w io.Writer) error) error {
+	if o.format == outputBoard {
+		return render(o.stdout)
+	}
+	return o.writeMessage(o.stdout, m)
+}
+
+func (o output) writeMessage(w io.Writer, m proto.Message) error {
+	var (
+		b   []byte
+		err error
+	)
+	switch o.format {
+	case outputJSON:
+		b, err = protojson.MarshalOptions{Multiline: !o.stream}.Marshal(m)
+
+	case outputYAML:
+		if b, err = protojson.Marshal(m); err == nil {
+			b, err = jsonToYAML(b)
+		}
+		if err == nil {
+			b = append([]byte("---\n"), b...)
+		}
+
+	case outputProtoText:
+		b, err = prototext.MarshalOptions{Multiline: true}.Marshal(m)
+	}
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", o.format, err)
+	}
+
+	// json and text leave the final newline off.
+	if len(b) == 0 || b[len(b)-1] != '\n' {
+		b = append(b, '\n')
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// usage reports that the command was called incorrectly.
+func (o output) usage(msg string) int {
+	o.error(msg, nil, exitUsage)
+	return exitUsage
+}
+
+// fail reports that the command failed with err.
+func (o output) fail(msg string, err error) int {
+	o.error(msg, err, exitFailure)
+	return exitFailure
+}
+
+// error reports the error to stderr. In the board format it is logged, as
+// before, but the other formats write an error message holding the message,
+// the error, its connect code if it has one, and the exit code:
+//
+//	{"error": {"message": "failed to view game", "error": "not_found: game not found", "code": "not_found", "exit_code": 1}}
+func (o output) error(msg string, err error, code int) {
+	if o.format == outputBoard {
+		if err == nil {
+			o.log.Error(msg)
+			return
+		}
+		o.log.Error(msg, slog.String("error", err.Error()))
+		return
+	}
+
+	fields := map[string]any{"message": msg, "exit_code": code}
+	if err != nil {
+		fields["error"] = err.Error()
+
+		var ce *connect.Error
+		if errors.As(err, &ce) {
+			fields["code"] = ce.Code().String()
+		}
+	}
+
+	s, sErr := structpb.NewStruct(map[string]any{"error": fields})
+	if sErr == nil {
+		sErr = o.writeMessage(o.stderr, s)
+	}
+	if sErr != nil {
+		// fall back to the log, so the error isn't lost.
+		o.log.Error(msg, slog.Any("error", err), slog.String("output_error", sErr.Error()))
+	}
+}
@@ -5,44 +5,64 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode/utf8"
 
 	sweeperv1 "github.com/nightmarlin/sweeper/gen/sweeper/v1"
 )
 
-const (
-	renderCellEmpty    = ' '
-	renderCellFlagged  = '!'
-	renderCellQuestion = '?'
-	renderCellMine     = '╳'
-	renderCellUnknown  = '¿'
-
-	renderHeaderDividerVertical     = '│'
-	renderHeaderDividerHorizontal   = '─'
-	renderHeaderDividerJoint        = '┼'
-	renderStandardDividerVertical   = '╎'
-	renderStandardDividerHorizontal = '╌'
-	renderStandardDividerJoint      = '•'
-)
-
-func runeFromInt(i int32) rune {
-	if 0 <= i && i <= 9 {
-		return '0' + i
+func stringFromInt(t theme, i int32) string {
+	if 0 <= i {
+		return strconv.Itoa(int(i))
 	}
-	return renderCellUnknown
+	return t.Unknown
+}
+
+// renderCell is a cell of the board ready to be drawn.
+type renderCell struct {
+	text  string
+	style string // An SGR style, or empty if unstyled.
 }
 
 func renderGame(
 	ctx context.Context,
 	w io.Writer,
 	g *sweeperv1.Game,
+	t theme,
+) error {
+	return renderGameCursor(ctx, w, g, t, nil)
+}
+
+// renderGameCursor renders the game as renderGame does, highlighting the cell
+// under the cursor if there is one.
+func renderGameCursor(
+	ctx context.Context,
+	w io.Writer,
+	g *sweeperv1.Game,
+	t theme,
+	cursor *sweeperv1.CellRef,
 ) error {
-	cells := make([][]rune, g.Board.Height)
+	// only the region of the board the game holds is rendered
+	region := g.Region
+	if region == nil {
+		region = &sweeperv1.Region{Rows: g.Board.Height, Columns: g.Board.Width}
+	}
+
+	cells := make([][][]renderCell, max(g.Board.Depth, 1))
 	for i := range cells {
-		cells[i] = make([]rune, g.Board.Width)
+		cells[i] = make([][]renderCell, region.Rows)
+		for j := range cells[i] {
+			cells[i][j] = make([]renderCell, region.Columns)
+		}
 	}
 
 	// accumulate cells into slice for render
-	var flaggedCells int
+	var (
+		flags     int32
+		cellWidth = 1
+	)
 
 	for _, c := range g.Cells {
 		select {
@@ -51,50 +71,167 @@ func renderGame(
 		default:
 		}
 
-		r := renderCellUnknown
+		r := renderCell{text: t.Unknown}
 
 		switch s := c.State.(type) {
 		case *sweeperv1.Cell_Unrevealed:
-			r = renderCellEmpty
+			r.text = t.Empty
 
 		case *sweeperv1.Cell_Questioned:
-			r = renderCellQuestion
+			r = renderCell{text: t.Question, style: t.QuestionStyle}
 
 		case *sweeperv1.Cell_Flagged:
-			r = renderCellFlagged
-			flaggedCells++
+			r = renderCell{text: t.Flagged, style: t.FlaggedStyle}
+			flags += max(c.Flags, 1)
+
+			// cells holding more than one flag show how many they hold
+			if c.Flags > 1 {
+				r.text += stringFromInt(t, c.Flags)
+			}
 
 		case *sweeperv1.Cell_Revealed:
 			switch v := s.Revealed.Value.(type) {
 			case *sweeperv1.RevealedCell_Clear:
-				r = runeFromInt(v.Clear.NeighbouringMines)
+				r = renderCell{
+					text:  stringFromInt(t, v.Clear.NeighbouringMines),
+					style: t.numberStyle(v.Clear.NeighbouringMines),
+				}
 
 			case *sweeperv1.RevealedCell_Mine:
-				r = renderCellMine
+				r = renderCell{text: t.Mine, style: t.MineStyle}
+
+				// only the mine that lost the game is ever revealed in a lost game;
+				// under the flags rules, revealed mines are claimed ones.
+				if g.State == sweeperv1.GameState_LOST {
+					r.style = t.LosingMineStyle
+				}
 			}
 		}
 
-		cells[c.Row][c.Column] = r
+		cells[c.Layer][c.Row-region.Top][c.Column-region.Left] = r
+		cellWidth = max(cellWidth, utf8.RuneCountInString(r.text))
+	}
+
+	// the summary counts every flag, even those outside the region
+	if g.Summary != nil {
+		flags = g.Summary.FlagsPlaced
 	}
 
 	// render game header
+	state := gameStateToString(g.State)
+	if g.Board.Rules == sweeperv1.Rules_MINE_FLAGS && g.State == sweeperv1.GameState_WON {
+		state = "Game over."
+	}
 	if _, err := fmt.Fprintf(
-		w, "Game '%s'\n%s\t%d/%d\n",
-		g.Id, gameStateToString(g.State), flaggedCells, g.Board.Mines,
+		w, "Game '%s'\n%s\t%s %d/%d",
+		g.Id, state, t.Separator, flags, g.Board.Mines,
 	); err != nil {
 		return err
 	}
+	if tp := g.Board.Topology; tp != sweeperv1.Topology_SQUARE && tp != sweeperv1.Topology_TOPOLOGY_UNKNOWN {
+		if _, err := fmt.Fprintf(
+			w, "\t%s %s",
+			t.Separator, topologyToString(tp),
+		); err != nil {
+			return err
+		}
+	}
+	if g.Board.Wrap {
+		if _, err := fmt.Fprintf(w, "\t%s toroidal", t.Separator); err != nil {
+			return err
+		}
+	}
+	if g.Board.MaxMinesPerCell > 1 {
+		if _, err := fmt.Fprintf(
+			w, "\t%s %d mines/cell",
+			t.Separator, g.Board.MaxMinesPerCell,
+		); err != nil {
+			return err
+		}
+	}
+	if g.Board.Rules == sweeperv1.Rules_MINE_FLAGS {
+		if _, err := fmt.Fprintf(w, "\t%s flags", t.Separator); err != nil {
+			return err
+		}
+	}
+	if g.TimeRemaining != nil {
+		if _, err := fmt.Fprintf(
+			w, "\t%s %s left",
+			t.Separator, g.TimeRemaining.AsDuration().Round(time.Second),
+		); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+
+	// render players with how many cells each has opened, or for flags games,
+	// how many mines each has claimed
+	if len(g.Players) > 1 {
+		players := make([]string, 0, len(g.Players))
+		for _, p := range g.Players {
+			score := p.CellsOpened
+			if g.Board.Rules == sweeperv1.Rules_MINE_FLAGS {
+				score = p.Score
+			}
+
+			player := fmt.Sprintf("%s (%d)", p.Id, score)
+			if p.Id == g.TurnPlayerId {
+				player = fmt.Sprintf("%s %s", t.Flagged, player)
+			}
+			players = append(players, player)
+		}
+		if _, err := fmt.Fprintf(w, "Players: %s\n", strings.Join(players, ", ")); err != nil {
+			return err
+		}
+	}
+	if g.WinnerId != "" {
+		if _, err := fmt.Fprintf(w, "%s won!\n", g.WinnerId); err != nil {
+			return err
+		}
+	}
 
+	// render each layer of the board
+	colWidth := max(len(strconv.Itoa(int(g.Board.Width)+1)), cellWidth)
+	for layerNum, layer := range cells {
+		if len(cells) > 1 {
+			if _, err := fmt.Fprintf(w, "\nLayer %d\n", layerNum+1); err != nil {
+				return err
+			}
+		}
+
+		var layerCursor *sweeperv1.CellRef
+		if cursor != nil && int(cursor.Layer) == layerNum {
+			layerCursor = cursor
+		}
+		if err := renderLayer(w, g.Board, region, t, layer, colWidth, layerCursor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderLayer renders the region of a single layer of the board, given as rows
+// of cells. The cell under the cursor, if any, is shown in reverse video.
+func renderLayer(
+	w io.Writer,
+	b *sweeperv1.Board,
+	region *sweeperv1.Region,
+	t theme,
+	cells [][]renderCell,
+	colWidth int,
+	cursor *sweeperv1.CellRef,
+) error {
 	// render column titles
-	rowNameWidth := len(strconv.Itoa(int(g.Board.Height) + 1))
-	colWidth := len(strconv.Itoa(int(g.Board.Width) + 1))
+	rowNameWidth := len(strconv.Itoa(int(b.Height) + 1))
 	if _, err := fmt.Fprintf(w, ` %s`, pad(" ", rowNameWidth)); err != nil {
 		return err
 	}
-	for col := range g.Board.Width {
+	for col := range region.Columns {
 		if _, err := fmt.Fprintf(
-			w, " %c %s",
-			renderHeaderDividerVertical, pad(strconv.Itoa(int(col)+1), colWidth),
+			w, " %s %s",
+			t.HeaderVertical, pad(strconv.Itoa(int(region.Left+col)+1), colWidth),
 		); err != nil {
 			return err
 		}
@@ -104,28 +241,30 @@ func renderGame(
 	}
 
 	// render slice to board
-	for rowNum, row := range cells {
+	for i, row := range cells {
+		rowNum := int(region.Top) + i
+
 		// print header for row number
-		if _, err := fmt.Fprintf(w, printN(renderHeaderDividerHorizontal, rowNameWidth+2)); err != nil {
+		if _, err := fmt.Fprint(w, strings.Repeat(t.HeaderHorizontal, rowNameWidth+2)); err != nil {
 			return err
 		}
 
 		// if first row, render solid top dividers, else render dotted top dividers
 		var (
-			horizDiv = renderStandardDividerHorizontal
-			join     = renderStandardDividerJoint
+			horizDiv = t.Horizontal
+			join     = t.Joint
 		)
-		if rowNum == 0 {
-			horizDiv = renderHeaderDividerHorizontal
-			join = renderHeaderDividerJoint
+		if i == 0 {
+			horizDiv = t.HeaderHorizontal
+			join = t.HeaderJoint
 		}
-		for colNum := range g.Board.Width {
+		for colNum := range region.Columns {
 			join := join
 			if colNum == 0 {
-				join = renderHeaderDividerJoint
+				join = t.HeaderJoint
 			}
 
-			if _, err := fmt.Fprintf(w, "%c%s", join, printN(horizDiv, colWidth+2)); err != nil {
+			if _, err := fmt.Fprintf(w, "%s%s", join, strings.Repeat(horizDiv, colWidth+2)); err != nil {
 				return err
 			}
 		}
@@ -136,28 +275,184 @@ func renderGame(
 		}
 
 		// render cells
-		for colNum, cell := range row {
+		var line strings.Builder
+		for j, cell := range row {
+			colNum := int(region.Left) + j
+
 			// if first column render solid vertical divider, else dotted
-			div := renderStandardDividerVertical
-			if colNum == 0 {
-				div = renderHeaderDividerVertical
+			div := t.Vertical
+			switch {
+			case j == 0:
+				div = t.HeaderVertical
+
+			case b.Topology == sweeperv1.Topology_TRIANGLE:
+				// slope the divider to match the edge between the neighbouring triangles
+				div = t.TriangleFalling
+				if (rowNum+colNum)%2 == 0 {
+					div = t.TriangleRising
+				}
+			}
+
+			_, _ = fmt.Fprintf(&line, ` %s`, div)
+
+			// hex grids offset odd rows by half a cell
+			if j == 0 && b.Topology == sweeperv1.Topology_HEX && rowNum%2 != 0 {
+				line.WriteString(pad("", (colWidth+3)/2))
+			}
+
+			// styles are applied after padding, as their escape codes take up no room.
+			style := cell.style
+			if cursor != nil && int(cursor.Row) == rowNum && int(cursor.Column) == colNum {
+				style = strings.TrimPrefix(style+";7", ";")
+			}
+			_, _ = fmt.Fprintf(&line, ` %s`, styled(pad(cell.text, colWidth), style))
+		}
+
+		// unrevealed cells at the end of the row would leave trailing whitespace
+		if _, err := fmt.Fprintln(w, strings.TrimRight(line.String(), " ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// styled wraps s in the escape codes for the SGR style, resetting it after.
+func styled(s, style string) string {
+	if style == "" {
+		return s
+	}
+	return fmt.Sprintf("\x1b[%sm%s\x1b[0m", style, s)
+}
+
+func renderMatch(
+	ctx context.Context,
+	w io.Writer,
+	m *sweeperv1.Match,
+	t theme,
+) error {
+	status := "Ongoing."
+	switch {
+	case m.WinnerId != "":
+		status = fmt.Sprintf("%s won!", m.WinnerId)
+	case m.Finished:
+		status = "Nobody won."
+	}
+
+	if _, err := fmt.Fprintf(
+		w, "Match '%s'\n%s\t%s %dx%d, %d mines\n",
+		m.Id, status, t.Separator, m.Board.Height, m.Board.Width, m.Board.Mines,
+	); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "Player\tGame\tState\tRevealed\tFlagged"); err != nil {
+		return err
+	}
+	for _, p := range m.Players {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if _, err := fmt.Fprintf(
+			tw, "%s\t%s\t%s\t%d\t%d\n",
+			p.PlayerId, p.GameId, gameStateToString(p.State), p.CellsRevealed, p.FlagsPlaced,
+		); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+func renderTournament(
+	ctx context.Context,
+	w io.Writer,
+	t *sweeperv1.Tournament,
+	th theme,
+) error {
+	status := "Ongoing."
+	if t.WinnerId != "" {
+		status = fmt.Sprintf("%s won!", t.WinnerId)
+	}
+
+	format := "fastest time"
+	if t.Format == sweeperv1.TournamentFormat_BEST_OF {
+		format = fmt.Sprintf("best of %d", t.BestOf)
+	}
+
+	if _, err := fmt.Fprintf(
+		w, "Tournament '%s'\n%s\t%s %s\t%s %dx%d, %d mines\n",
+		t.Id, status,
+		th.Separator, format,
+		th.Separator, t.Board.Height, t.Board.Width, t.Board.Mines,
+	); err != nil {
+		return err
+	}
+
+	for i, r := range t.Rounds {
+		if _, err := fmt.Fprintf(w, "\nRound %d\n", i+1); err != nil {
+			return err
+		}
+
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		if _, err := fmt.Fprintln(tw, "Players\tHeats\tScore\tWinner"); err != nil {
+			return err
+		}
+		for _, p := range r.Pairings {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+
+			score := "bye"
+			if len(p.PlayerIds) > 1 {
+				wins := make([]string, 0, len(p.Wins))
+				for _, w := range p.Wins {
+					wins = append(wins, stringFromInt(th, w))
+				}
+				score = strings.Join(wins, "-")
+			}
+
+			winner := p.WinnerId
+			if winner == "" {
+				winner = "-"
 			}
 
 			if _, err := fmt.Fprintf(
-				w, ` %c %s`,
-				div, pad(fmt.Sprintf("%c", cell), colWidth),
+				tw, "%s\t%d\t%s\t%s\n",
+				strings.Join(p.PlayerIds, " vs "), len(p.Heats), score, winner,
 			); err != nil {
 				return err
 			}
 		}
-
-		if _, err := fmt.Fprintln(w); err != nil {
+		if err := tw.Flush(); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// renderGames renders the recently seen games, marking the current one.
+func renderGames(w io.Writer, games *serverGames) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "\tAlias\tGame"); err != nil {
+		return err
+	}
+	for _, g := range games.Games {
+		var current string
+		if g.ID == games.Current {
+			current = "*"
+		}
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\n", current, g.Alias, g.ID); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
 func gameStateToString(s sweeperv1.GameState) string {
 	switch s {
 	case sweeperv1.GameState_ONGOING:
@@ -168,20 +463,28 @@ func gameStateToString(s sweeperv1.GameState) string {
 		return "You lost."
 	case sweeperv1.GameState_RESIGNED:
 		return "You resigned."
+	case sweeperv1.GameState_TIMED_OUT:
+		return "You ran out of time."
+	case sweeperv1.GameState_DRAWN:
+		return "It's a draw."
 	default:
 		return "Unknown State"
 	}
 }
 
-func printN(c rune, n int) (s string) {
-	for range n {
-		s = fmt.Sprintf("%s%c", s, c)
+func topologyToString(t sweeperv1.Topology) string {
+	switch t {
+	case sweeperv1.Topology_HEX:
+		return "hex"
+	case sweeperv1.Topology_TRIANGLE:
+		return "triangle"
+	default:
+		return "square"
 	}
-	return
 }
 
 func pad(s string, l int) string {
-	for len(s) < l {
+	for utf8.RuneCountInString(s) < l {
 		s = fmt.Sprintf(" %s", s)
 	}
 	return s
@@ -2,13 +2,17 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"slices"
+	"strings"
 
 	sweeperv1 "github.com/nightmarlin/sweeper/gen/sweeper/v1"
 )
 
-func Example_renderGame() {
-	var g = &sweeperv1.Game{
+// exampleGame returns a lost game showing each kind of cell.
+func exampleGame() *sweeperv1.Game {
+	return &sweeperv1.Game{
 		Id:    "0_example_game",
 		State: sweeperv1.GameState_LOST,
 		Board: &sweeperv1.Board{Height: 3, Width: 3, Mines: 2},
@@ -54,11 +58,19 @@ func Example_renderGame() {
 			{Row: 2, Column: 2, State: &sweeperv1.Cell_Unrevealed{}},
 		},
 	}
+}
+
+// printEscaped prints s with its escape codes made visible.
+func printEscaped(s string) {
+	fmt.Print(strings.ReplaceAll(s, "\x1b", `\e`))
+}
 
+func Example_renderGame() {
 	_ = renderGame(
 		context.Background(),
 		os.Stdout,
-		g,
+		exampleGame(),
+		themeDefault.plain(),
 	)
 
 	// Output: Game '0_example_game'
@@ -71,3 +83,155 @@ func Example_renderGame() {
 	// ───┼╌╌╌•╌╌╌•╌╌╌
 	//  3 │ ╳ ╎   ╎
 }
+
+func Example_renderGame_colour() {
+	var buf strings.Builder
+	_ = renderGame(context.Background(), &buf, exampleGame(), themeDefault)
+	printEscaped(buf.String())
+
+	// Output: Game '0_example_game'
+	// You lost.	• 1/2
+	//    │ 1 │ 2 │ 3
+	// ───┼───┼───┼───
+	//  1 │ 0 ╎   ╎ \e[1;33m!\e[0m
+	// ───┼╌╌╌•╌╌╌•╌╌╌
+	//  2 │ \e[94m1\e[0m ╎ \e[35m?\e[0m ╎
+	// ───┼╌╌╌•╌╌╌•╌╌╌
+	//  3 │ \e[1;97;41m╳\e[0m ╎   ╎
+}
+
+func Example_renderGame_ascii() {
+	var buf strings.Builder
+	_ = renderGame(context.Background(), &buf, exampleGame(), themeASCII)
+	printEscaped(buf.String())
+
+	// Output: Game '0_example_game'
+	// You lost.	* 1/2
+	//    | 1 | 2 | 3
+	// ---+---+---+---
+	//  1 | 0 :   : \e[1;33m!\e[0m
+	// ---+...+...+...
+	//  2 | \e[94m1\e[0m : \e[35m?\e[0m :
+	// ---+...+...+...
+	//  3 | \e[1;97;41mX\e[0m :   :
+}
+
+func Example_renderGame_hex() {
+	var g = &sweeperv1.Game{
+		Id:    "1_hex_game",
+		State: sweeperv1.GameState_ONGOING,
+		Board: &sweeperv1.Board{Height: 3, Width: 3, Mines: 1, Topology: sweeperv1.Topology_HEX},
+	}
+	for row := range g.Board.Height {
+		for col := range g.Board.Width {
+			g.Cells = append(g.Cells, &sweeperv1.Cell{
+				Row:    row,
+				Column: col,
+				State: &sweeperv1.Cell_Revealed{
+					Revealed: &sweeperv1.RevealedCell{
+						Value: &sweeperv1.RevealedCell_Clear{
+							Clear: &sweeperv1.ClearRevealedCell{NeighbouringMines: row*3 + col},
+						},
+					},
+				},
+			})
+		}
+	}
+
+	_ = renderGame(
+		context.Background(),
+		os.Stdout,
+		g,
+		themeDefault.plain(),
+	)
+
+	// Output: Game '1_hex_game'
+	// Ongoing.	• 0/1	• hex
+	//    │ 1 │ 2 │ 3
+	// ───┼───┼───┼───
+	//  1 │ 0 ╎ 1 ╎ 2
+	// ───┼╌╌╌•╌╌╌•╌╌╌
+	//  2 │   3 ╎ 4 ╎ 5
+	// ───┼╌╌╌•╌╌╌•╌╌╌
+	//  3 │ 6 ╎ 7 ╎ 8
+}
+
+func Example_renderGame_layered() {
+	var g = &sweeperv1.Game{
+		Id:    "2_layered_game",
+		State: sweeperv1.GameState_ONGOING,
+		Board: &sweeperv1.Board{Height: 2, Width: 2, Depth: 2, Mines: 1},
+	}
+	for layer := range g.Board.Depth {
+		for row := range g.Board.Height {
+			for col := range g.Board.Width {
+				c := &sweeperv1.Cell{
+					Layer:  layer,
+					Row:    row,
+					Column: col,
+					State:  &sweeperv1.Cell_Unrevealed{},
+				}
+				if layer == 0 {
+					c.State = &sweeperv1.Cell_Revealed{
+						Revealed: &sweeperv1.RevealedCell{
+							Value: &sweeperv1.RevealedCell_Clear{
+								Clear: &sweeperv1.ClearRevealedCell{NeighbouringMines: 1},
+							},
+						},
+					}
+				}
+				g.Cells = append(g.Cells, c)
+			}
+		}
+	}
+
+	_ = renderGame(
+		context.Background(),
+		os.Stdout,
+		g,
+		themeDefault.plain(),
+	)
+
+	// Output: Game '2_layered_game'
+	// Ongoing.	• 0/1
+	//
+	// Layer 1
+	//    │ 1 │ 2
+	// ───┼───┼───
+	//  1 │ 1 ╎ 1
+	// ───┼╌╌╌•╌╌╌
+	//  2 │ 1 ╎ 1
+	//
+	// Layer 2
+	//    │ 1 │ 2
+	// ───┼───┼───
+	//  1 │   ╎
+	// ───┼╌╌╌•╌╌╌
+	//  2 │   ╎
+}
+
+func Example_renderGame_region() {
+	g := exampleGame()
+	g.Region = &sweeperv1.Region{Top: 1, Left: 1, Rows: 2, Columns: 2}
+	g.Summary = &sweeperv1.GameSummary{FlagsPlaced: 1, CellsRevealed: 3, MinesRemaining: 1}
+
+	// the server only sends the cells in the region
+	g.Cells = slices.DeleteFunc(g.Cells, func(c *sweeperv1.Cell) bool {
+		return c.Row < 1 || c.Column < 1
+	})
+
+	_ = renderGame(
+		context.Background(),
+		os.Stdout,
+		g,
+		themeDefault.plain(),
+	)
+
+	// Output: Game '0_example_game'
+	// You lost.	• 1/2
+	//    │ 2 │ 3
+	// ───┼───┼───
+	//  2 │ ? ╎
+	// ───┼╌╌╌•╌╌╌
+	//  3 │   ╎
+}
@@ -0,0 +1,16 @@
+//go:build !unix
+
+package main
+
+import (
+	"errors"
+	"os"
+)
+
+func makeRaw() (restore func() error, err error) {
+	return nil, errors.New("the terminal ui is not supported on this platform")
+}
+
+// notifyResize does nothing, as resizes can't be detected. The board is still
+// redrawn each second.
+func notifyResize(chan<- os.Signal) (stop func()) { return func() {} }
@@ -0,0 +1,42 @@
+//go:build unix
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+)
+
+// makeRaw puts the terminal on stdin into raw mode, so keys are read as they
+// are pressed without being echoed. restore puts it back how it was.
+func makeRaw() (restore func() error, err error) {
+	saved, err := stty("-g")
+	if err != nil {
+		return nil, fmt.Errorf("saving terminal state: %w", err)
+	}
+	if _, err := stty("raw", "-echo"); err != nil {
+		return nil, fmt.Errorf("entering raw mode: %w", err)
+	}
+	return func() error {
+		_, err := stty(strings.TrimSpace(saved))
+		return err
+	}, nil
+}
+
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	out, err := cmd.Output()
+	return string(out), err
+}
+
+// notifyResize relays a signal to c each time the terminal is resized, until
+// stop is called.
+func notifyResize(c chan<- os.Signal) (stop func()) {
+	signal.Notify(c, syscall.SIGWINCH)
+	return func() { signal.Stop(c) }
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"unicode/utf8"
+)
+
+// theme decides how boards are drawn. Every glyph must be a single character.
+//
+// Styles are ANSI SGR parameters, such as "1;31" for bold red. They are left
+// out when colour is disabled.
+type theme struct {
+	Empty    string `json:"empty"`
+	Flagged  string `json:"flagged"`
+	Question string `json:"question"`
+	Mine     string `json:"mine"`
+	Unknown  string `json:"unknown"`
+
+	Separator string `json:"separator"`
+
+	HeaderVertical   string `json:"header_vertical"`
+	HeaderHorizontal string `json:"header_horizontal"`
+	HeaderJoint      string `json:"header_joint"`
+	Vertical         string `json:"vertical"`
+	Horizontal       string `json:"horizontal"`
+	Joint            string `json:"joint"`
+	TriangleRising   string `json:"triangle_rising"`
+	TriangleFalling  string `json:"triangle_falling"`
+
+	// Numbers holds the style of revealed cells by how many mines neighbour them.
+	// Cells with more neighbouring mines than it covers use its last style.
+	Numbers         []string `json:"numbers"`
+	FlaggedStyle    string   `json:"flagged_style"`
+	QuestionStyle   string   `json:"question_style"`
+	MineStyle       string   `json:"mine_style"`
+	LosingMineStyle string   `json:"losing_mine_style"` // The mine that lost the game.
+}
+
+var (
+	// themeDefault draws the board with box-drawing characters.
+	themeDefault = theme{
+		Empty:    " ",
+		Flagged:  "!",
+		Question: "?",
+		Mine:     "╳",
+		Unknown:  "¿",
+
+		Separator: "•",
+
+		HeaderVertical:   "│",
+		HeaderHorizontal: "─",
+		HeaderJoint:      "┼",
+		Vertical:         "╎",
+		Horizontal:       "╌",
+		Joint:            "•",
+		TriangleRising:   "╱",
+		TriangleFalling:  "╲",
+
+		// the colours used by the original game: blue, green, red, navy, maroon,
+		// teal, black and grey.
+		Numbers:         []string{"", "94", "32", "91", "34", "31", "36", "1", "90"},
+		FlaggedStyle:    "1;33",
+		QuestionStyle:   "35",
+		MineStyle:       "1",
+		LosingMineStyle: "1;97;41",
+	}
+
+	// themeASCII is themeDefault for terminals that can only draw ASCII.
+	themeASCII = func() theme {
+		t := themeDefault
+		t.Mine, t.Unknown = "X", "~"
+		t.Separator = "*"
+		t.HeaderVertical, t.HeaderHorizontal, t.HeaderJoint = "|", "-", "+"
+		t.Vertical, t.Horizontal, t.Joint = ":", ".", "+"
+		t.TriangleRising, t.TriangleFalling = "/", `\`
+		return t
+	}()
+
+	themes = map[string]theme{
+		"default": themeDefault,
+		"ascii":   themeASCII,
+	}
+)
+
+// plain returns a copy of the theme without any styles.
+func (t theme) plain() theme {
+	t.Numbers = nil
+	t.FlaggedStyle, t.QuestionStyle, t.MineStyle, t.LosingMineStyle = "", "", "", ""
+	return t
+}
+
+// numberStyle returns the style of a revealed cell with n neighbouring mines.
+func (t theme) numberStyle(n int32) string {
+	if len(t.Numbers) == 0 || n < 0 {
+		return ""
+	}
+	return t.Numbers[min(int(n), len(t.Numbers)-1)]
+}
+
+func (t theme) validate() error {
+	for name, g := range map[string]string{
+		"empty":             t.Empty,
+		"flagged":           t.Flagged,
+		"question":          t.Question,
+		"mine":              t.Mine,
+		"unknown":           t.Unknown,
+		"separator":         t.Separator,
+		"header_vertical":   t.HeaderVertical,
+		"header_horizontal": t.HeaderHorizontal,
+		"header_joint":      t.HeaderJoint,
+		"vertical":          t.Vertical,
+		"horizontal":        t.Horizontal,
+		"joint":             t.Joint,
+		"triangle_rising":   t.TriangleRising,
+		"triangle_falling":  t.TriangleFalling,
+	} {
+		if utf8.RuneCountInString(g) != 1 {
+			return fmt.Errorf("%w: %s must be a single character, got %q", errInvalidTheme, name, g)
+		}
+	}
+	return nil
+}
+
+// defaultThemesPath returns where user-defined themes are read from by default.
+func defaultThemesPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "sweeper", "themes.json")
+}
+
+// loadTheme returns the named theme, looking in the themes file at path after
+// the built-in themes. The file holds a JSON object of themes by name. Each
+// overrides the fields it sets of its base theme, or the default theme if it
+// doesn't name one:
+//
+//	{"mono": {"base": "ascii", "mine": "@", "numbers": ["", "1"]}}
+//
+// The file is optional unless the theme isn't built in.
+func loadTheme(name, path string, colour bool) (theme, error) {
+	t, err := findTheme(name, path)
+	if err != nil {
+		return theme{}, err
+	}
+	if err := t.validate(); err != nil {
+		return theme{}, fmt.Errorf("theme %q: %w", name, err)
+	}
+	if !colour {
+		t = t.plain()
+	}
+	return t, nil
+}
+
+func findTheme(name, path string) (theme, error) {
+	if t, ok := themes[name]; ok {
+		return t, nil
+	}
+	if path == "" {
+		return theme{}, fmt.Errorf("%w: %q", errUnknownTheme, name)
+	}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return theme{}, fmt.Errorf("%w: %q", errUnknownTheme, name)
+	}
+	if err != nil {
+		return theme{}, fmt.Errorf("reading themes: %w", err)
+	}
+
+	var custom map[string]json.RawMessage
+	if err := json.Unmarshal(b, &custom); err != nil {
+		return theme{}, fmt.Errorf("%w: %w", errInvalidTheme, err)
+	}
+	raw, ok := custom[name]
+	if !ok {
+		return theme{}, fmt.Errorf("%w: %q", errUnknownTheme, name)
+	}
+
+	var base struct {
+		Base string `json:"base"`
+	}
+	if err := json.Unmarshal(raw, &base); err != nil {
+		return theme{}, fmt.Errorf("%w: %w", errInvalidTheme, err)
+	}
+	if base.Base == "" {
+		base.Base = "default"
+	}
+	t, ok := themes[base.Base]
+	if !ok {
+		return theme{}, fmt.Errorf("%w: base %q of %q", errUnknownTheme, base.Base, name)
+	}
+	// decoding into the slice would otherwise overwrite the base theme's styles.
+	t.Numbers = slices.Clone(t.Numbers)
+
+	if err := json.Unmarshal(raw, &t); err != nil {
+		return theme{}, fmt.Errorf("%w: %w", errInvalidTheme, err)
+	}
+	return t, nil
+}
+
+// useColour reports whether output should be coloured. With mode "auto",
+// colour is used when stdout is a terminal, unless NO_COLOR is set.
+func useColour(mode string) (bool, error) {
+	switch mode {
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	case "auto":
+		if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+			return false, nil
+		}
+		fi, err := os.Stdout.Stat()
+		return err == nil && fi.Mode()&os.ModeCharDevice != 0, nil
+	default:
+		return false, fmt.Errorf("unknown colour mode: %s", mode)
+	}
+}
+
+var (
+	errUnknownTheme = fmt.Errorf("unknown theme")
+	errInvalidTheme = fmt.Errorf("invalid theme")
+)
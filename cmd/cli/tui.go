@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	sweeperv1 "github.com/nightmarlin/sweeper/gen/sweeper/v1"
+)
+
+const (
+	tuiEnterAltScreen = "\x1b[?1049h\x1b[?25l"
+	tuiExitAltScreen  = "\x1b[?25h\x1b[?1049l"
+	tuiClearScreen    = "\x1b[H\x1b[2J"
+
+	tuiKeyInterrupt = 0x03 // Ctrl-C, which raw mode delivers as a key rather than a signal.
+
+	tuiHelp = "arrows/hjkl move %[1]s [ ] layer %[1]s space/r reveal %[1]s f flag %[1]s ? question %[1]s x clear %[1]s c chord %[1]s q quit"
+)
+
+// tui plays the game interactively, redrawing the board in place as keys are
+// pressed, until the player quits or ctx is cancelled. It returns the last
+// state of the game it saw.
+func (c client) tui(ctx context.Context, id string, th theme) (*sweeperv1.Game, error) {
+	g, err := c.view(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	restore, err := makeRaw()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = restore() }()
+
+	if _, err := fmt.Print(tuiEnterAltScreen); err != nil {
+		return nil, err
+	}
+	defer func() { _, _ = fmt.Print(tuiExitAltScreen) }()
+
+	keys := make(chan []byte)
+	go func() {
+		// stdin can't be interrupted, so this is left blocked once the ui closes.
+		buf := make([]byte, 64)
+		for {
+			n, err := os.Stdin.Read(buf)
+			if err != nil {
+				close(keys)
+				return
+			}
+			keys <- bytes.Clone(buf[:n])
+		}
+	}()
+
+	resized := make(chan os.Signal, 1)
+	stopResize := notifyResize(resized)
+	defer stopResize()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	s := &tuiState{game: g, theme: th, cursor: &sweeperv1.CellRef{}}
+	for {
+		if err := s.draw(ctx); err != nil {
+			return s.game, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return s.game, nil
+
+		case <-resized:
+
+		case <-ticker.C:
+			// other players may have moved in the meantime.
+			if g, err := c.view(ctx, id, nil); err == nil {
+				s.game = g
+			}
+
+		case b, ok := <-keys:
+			if !ok {
+				return s.game, nil
+			}
+			for _, k := range parseKeys(b) {
+				if k == 'q' || k == tuiKeyInterrupt {
+					return s.game, nil
+				}
+				s.handle(ctx, c, k)
+			}
+		}
+	}
+}
+
+type tuiState struct {
+	game   *sweeperv1.Game
+	theme  theme
+	cursor *sweeperv1.CellRef
+	err    error
+}
+
+// handle applies the key to the state, making a move if it is bound to one.
+func (s *tuiState) handle(ctx context.Context, c client, k rune) {
+	b := s.game.Board
+
+	var action sweeperv1.CellMoveAction
+	switch k {
+	case 'h':
+		s.cursor.Column = max(s.cursor.Column-1, 0)
+	case 'l':
+		s.cursor.Column = min(s.cursor.Column+1, b.Width-1)
+	case 'k':
+		s.cursor.Row = max(s.cursor.Row-1, 0)
+	case 'j':
+		s.cursor.Row = min(s.cursor.Row+1, b.Height-1)
+	case '[':
+		s.cursor.Layer = max(s.cursor.Layer-1, 0)
+	case ']':
+		s.cursor.Layer = min(s.cursor.Layer+1, max(b.Depth, 1)-1)
+
+	case ' ', 'r':
+		action = sweeperv1.CellMoveAction_REVEAL
+	case 'f':
+		action = sweeperv1.CellMoveAction_FLAG
+	case '?':
+		action = sweeperv1.CellMoveAction_QUESTION
+	case 'x':
+		action = sweeperv1.CellMoveAction_CLEAR
+	case 'c':
+		action = sweeperv1.CellMoveAction_CHORD
+	}
+	if action == sweeperv1.CellMoveAction_CELL_MOVE_ACTION_UNKNOWN {
+		return
+	}
+
+	g, err := c.move(ctx, s.game.Id, s.cursor, action)
+	s.err = err
+	if err == nil {
+		s.game = g
+	}
+}
+
+// draw clears the screen and renders the game below a status line.
+func (s *tuiState) draw(ctx context.Context) error {
+	var buf bytes.Buffer
+	if err := renderGameCursor(ctx, &buf, s.game, s.theme, s.cursor); err != nil {
+		return fmt.Errorf("rendering game state: %w", err)
+	}
+
+	status := fmt.Sprintf("\n%d mines left", s.minesLeft())
+	if s.game.State == sweeperv1.GameState_ONGOING && s.game.StartedAt != nil {
+		status += fmt.Sprintf("\t%s %s", s.theme.Separator, time.Since(s.game.StartedAt.AsTime()).Round(time.Second))
+	}
+	buf.WriteString(status + "\n" + fmt.Sprintf(tuiHelp, s.theme.Separator) + "\n")
+	if s.err != nil {
+		_, _ = fmt.Fprintf(&buf, "error: %v\n", s.err)
+	}
+
+	// raw mode stops the terminal from returning to the start of each line.
+	_, err := fmt.Print(tuiClearScreen + strings.ReplaceAll(buf.String(), "\n", "\r\n"))
+	return err
+}
+
+// minesLeft returns how many mines are yet to be flagged.
+func (s *tuiState) minesLeft() int32 {
+	var flags int32
+	for _, c := range s.game.Cells {
+		if _, ok := c.State.(*sweeperv1.Cell_Flagged); ok {
+			flags += max(c.Flags, 1)
+		}
+	}
+	return s.game.Board.Mines - flags
+}
+
+// parseKeys splits terminal input into keys, mapping the arrow keys onto their
+// vim equivalents. Other escape sequences are dropped.
+func parseKeys(b []byte) []rune {
+	var keys []rune
+	for i := 0; i < len(b); i++ {
+		if b[i] != 0x1b {
+			keys = append(keys, rune(b[i]))
+			continue
+		}
+		if i+1 >= len(b) || b[i+1] != '[' {
+			continue
+		}
+		if i+2 >= len(b) {
+			// a truncated sequence, whose '[' would otherwise change layer.
+			break
+		}
+
+		switch b[i+2] {
+		case 'A':
+			keys = append(keys, 'k')
+		case 'B':
+			keys = append(keys, 'j')
+		case 'C':
+			keys = append(keys, 'l')
+		case 'D':
+			keys = append(keys, 'h')
+		}
+		i += 2
+	}
+	return keys
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	sweeperv1 "github.com/nightmarlin/sweeper/gen/sweeper/v1"
+	"github.com/nightmarlin/sweeper/gen/sweeper/v1/sweeperv1connect"
+)
+
+func Example_parseKeys() {
+	// up, a truncated escape, an unknown escape, then left and two plain keys.
+	for _, k := range parseKeys([]byte("\x1b[Af\x1b[Z\x1b[Dq\x1b[")) {
+		fmt.Printf("%q ", k)
+	}
+	fmt.Println()
+
+	// Output: 'k' 'f' 'h' 'q'
+}
+
+// moveRecorder records the Cell moves made through it, answering each with the
+// Game it was made on.
+type moveRecorder struct {
+	sweeperv1connect.SweeperServiceClient
+	game *sweeperv1.Game
+}
+
+func (r moveRecorder) MakeMove(
+	_ context.Context,
+	req *connect.Request[sweeperv1.MakeMoveRequest],
+) (*connect.Response[sweeperv1.MakeMoveResponse], error) {
+	m := req.Msg.GetCell()
+	fmt.Printf("%s %d,%d,%d\n", m.Action, m.Layer, m.Row, m.Column)
+	return connect.NewResponse(&sweeperv1.MakeMoveResponse{Game: r.game}), nil
+}
+
+func Example_tuiState_handle() {
+	g := &sweeperv1.Game{Id: "game", Board: &sweeperv1.Board{Width: 3, Height: 2, Mines: 1}}
+	c := client{c: moveRecorder{game: g}}
+	s := &tuiState{game: g, cursor: &sweeperv1.CellRef{}}
+
+	// the cursor stops at the edges of the board, and unbound keys do nothing.
+	for _, k := range "hkllljjz]f[kc" {
+		s.handle(context.Background(), c, k)
+	}
+	fmt.Printf("cursor at %d,%d,%d\n", s.cursor.Layer, s.cursor.Row, s.cursor.Column)
+
+	// Output: FLAG 0,1,2
+	// CHORD 0,0,2
+	// cursor at 0,0,2
+}
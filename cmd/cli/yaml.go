@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// yamlNode is a decoded JSON value that remembers the order of its keys.
+type yamlNode struct {
+	keys   []string
+	fields map[string]*yamlNode // Set for objects.
+	items  []*yamlNode          // Set for arrays, which may be empty.
+	array  bool
+	scalar string // Set for anything else, as it would be written in JSON.
+}
+
+// jsonToYAML converts the JSON document to block-style YAML, keeping the order
+// of its keys. Strings are written as JSON strings, which are valid YAML.
+func jsonToYAML(b []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	n, err := decodeYAMLNode(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	switch {
+	case n.fields != nil && len(n.keys) > 0, n.array && len(n.items) > 0:
+		writeYAMLNode(&buf, n, 0)
+	default:
+		buf.WriteString(n.inline() + "\n")
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeYAMLNode(dec *json.Decoder) (*yamlNode, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			n := &yamlNode{fields: map[string]*yamlNode{}}
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				v, err := decodeYAMLNode(dec)
+				if err != nil {
+					return nil, err
+				}
+				n.keys = append(n.keys, key.(string))
+				n.fields[key.(string)] = v
+			}
+			_, err := dec.Token() // }
+			return n, err
+
+		case '[':
+			n := &yamlNode{array: true}
+			for dec.More() {
+				v, err := decodeYAMLNode(dec)
+				if err != nil {
+					return nil, err
+				}
+				n.items = append(n.items, v)
+			}
+			_, err := dec.Token() // ]
+			return n, err
+		}
+		return nil, fmt.Errorf("unexpected %v", t)
+
+	case string:
+		return &yamlNode{scalar: yamlQuote(t)}, nil
+	case json.Number:
+		return &yamlNode{scalar: yamlNumber(t)}, nil
+	case nil:
+		return &yamlNode{scalar: "null"}, nil
+	default:
+		return &yamlNode{scalar: fmt.Sprint(t)}, nil
+	}
+}
+
+// yamlNumber returns the number as YAML reads it. YAML 1.1 only reads
+// exponents after a decimal point, so they are written out in full.
+func yamlNumber(n json.Number) string {
+	if !strings.ContainsAny(string(n), "eE") {
+		return string(n)
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return string(n)
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// inline returns the node as it is written on the same line as its key, or
+// the empty string if it must be written on the lines below.
+func (n *yamlNode) inline() string {
+	switch {
+	case n.fields != nil && len(n.keys) == 0:
+		return "{}"
+	case n.array && len(n.items) == 0:
+		return "[]"
+	case n.fields != nil || n.array:
+		return ""
+	default:
+		return n.scalar
+	}
+}
+
+var (
+	yamlPlainKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+	// yamlReserved holds the plain words YAML parsers may read as something
+	// other than a string.
+	yamlReserved = map[string]bool{
+		"null": true, "true": true, "false": true,
+		"yes": true, "no": true, "on": true, "off": true, "y": true, "n": true,
+	}
+)
+
+func writeYAMLNode(buf *bytes.Buffer, n *yamlNode, indent int) {
+	prefix := strings.Repeat(" ", indent)
+
+	if n.array {
+		for _, item := range n.items {
+			if v := item.inline(); v != "" {
+				buf.WriteString(prefix + "- " + v + "\n")
+				continue
+			}
+
+			// nested blocks start on the same line as their dash.
+			var child bytes.Buffer
+			writeYAMLNode(&child, item, indent+2)
+			buf.WriteString(prefix + "- " + strings.TrimPrefix(child.String(), prefix+"  "))
+		}
+		return
+	}
+
+	for _, k := range n.keys {
+		key := k
+		if !yamlPlainKey.MatchString(k) || yamlReserved[strings.ToLower(k)] {
+			key = yamlQuote(k)
+		}
+
+		v := n.fields[k]
+		if s := v.inline(); s != "" {
+			buf.WriteString(prefix + key + ": " + s + "\n")
+			continue
+		}
+		buf.WriteString(prefix + key + ":\n")
+		writeYAMLNode(buf, v, indent+2)
+	}
+}
+
+// yamlQuote returns s as a double-quoted string. Unlike json.Marshal, it
+// leaves HTML characters alone, but escapes U+0085, which YAML 1.1 reads as a
+// line break.
+func yamlQuote(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s) // strings always encode.
+	return strings.ReplaceAll(strings.TrimSuffix(buf.String(), "\n"), "\u0085", `\u0085`)
+}
@@ -0,0 +1,28 @@
+package main
+
+import "fmt"
+
+func Example_jsonToYAML() {
+	b, _ := jsonToYAML([]byte(`{
+		"id": "<game>",
+		"board": {"height": 2, "width": 2},
+		"cells": [{"row": 1, "flagged": {}}, {"column": 1}],
+		"players": [],
+		"y": [["a", true], null]
+	}`))
+	fmt.Print(string(b))
+
+	// Output: id: "<game>"
+	// board:
+	//   height: 2
+	//   width: 2
+	// cells:
+	//   - row: 1
+	//     flagged: {}
+	//   - column: 1
+	// players: []
+	// "y":
+	//   - - "a"
+	//     - true
+	//   - null
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestJSONToYAML_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	for name, doc := range map[string]string{
+		"reserved words": `{"yes": "yes", "No": "no", "ON": "on", "off": "OFF", "y": "Y", "n": "n", "true": "True", "null": "~"}`,
+		"indicators":     `{"-a": "- a", ":b": ": b", "#c": "#c", "~": "~", "?": "?", "&d": "*d", "!e": "!e", "|": ">", "@": "` + "`" + `"}`,
+		"punctuation":    `{"a b": "a: b", "c #d": "c #d", "[e]": "{f}", "'g'": "'g'", "\"h\"": "\"h\"", "i,j": "%k"}`,
+		"numeric":        `{"1": "1", "0x1F": "0x1F", "1_000": "1_000", "12:30": "12:30", ".inf": ".NaN", "n": [1, -2.5, 1e3, 2E-2, 0]}`,
+		"empty":          `{"": "", "a": {}, "b": [], "c": null, "d": [{}, [], ""]}`,
+		"line breaks":    `{"a": "b\nc", "d": "e\u0085f\u2028g\u2029h\ri"}`,
+		"nested":         `{"a": [[1, "yes"], [{"no": ["~"]}]], "b": {"c": {"d": "-"}}}`,
+		"scalar":         `"yes"`,
+		"array":          `["no", "~", null, true]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := jsonToYAML([]byte(doc))
+			if err != nil {
+				t.Fatalf("converting to yaml: %v", err)
+			}
+			if i := strings.IndexAny(string(b), "\r\u0085\u2028\u2029"); i >= 0 {
+				t.Errorf("yaml holds a raw line break at %d:\n%s", i, b)
+			}
+
+			got, err := readYAML(string(b))
+			if err != nil {
+				t.Fatalf("reading yaml: %v\n%s", err, b)
+			}
+			var want any
+			if err := json.Unmarshal([]byte(doc), &want); err != nil {
+				t.Fatalf("decoding json: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("want %#v, got %#v, from\n%s", want, got, b)
+			}
+		})
+	}
+}
+
+// readYAML reads the block-style YAML jsonToYAML writes into the values
+// json.Unmarshal would decode, resolving unquoted scalars the way YAML 1.1
+// parsers do, so a scalar written without the quotes it needs changes type.
+func readYAML(doc string) (any, error) {
+	var lines []yamlLine
+	for _, l := range strings.Split(strings.TrimSuffix(doc, "\n"), "\n") {
+		text := strings.TrimLeft(l, " ")
+		lines = append(lines, yamlLine{indent: len(l) - len(text), text: text})
+	}
+
+	r := &yamlReader{lines: lines}
+	v, err := r.block(0)
+	if err == nil && r.i < len(lines) {
+		err = fmt.Errorf("line %d: unexpected %q", r.i+1, lines[r.i].text)
+	}
+	return v, err
+}
+
+type yamlLine struct {
+	indent int
+	text   string
+}
+
+type yamlReader struct {
+	lines []yamlLine
+	i     int
+}
+
+// block reads the sequence, mapping or scalar starting at the current line,
+// which must be indented by indent.
+func (r *yamlReader) block(indent int) (any, error) {
+	l := r.lines[r.i]
+	if l.indent != indent {
+		return nil, fmt.Errorf("line %d: want indent %d, got %d", r.i+1, indent, l.indent)
+	}
+
+	switch {
+	case strings.HasPrefix(l.text, "- "):
+		var seq []any
+		for r.i < len(r.lines) && r.lines[r.i].indent == indent && strings.HasPrefix(r.lines[r.i].text, "- ") {
+			// the item continues as though its dash were indentation.
+			r.lines[r.i] = yamlLine{indent: indent + 2, text: r.lines[r.i].text[2:]}
+			v, err := r.block(indent + 2)
+			if err != nil {
+				return nil, err
+			}
+			seq = append(seq, v)
+		}
+		return seq, nil
+
+	default:
+		key, rest, ok, err := cutYAMLKey(l.text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.i+1, err)
+		}
+		if !ok {
+			r.i++
+			return yamlScalar(l.text)
+		}
+
+		m := map[string]any{}
+		for {
+			var (
+				v   any
+				err error
+			)
+			r.i++
+			if rest == "" {
+				if r.i == len(r.lines) || r.lines[r.i].indent < indent {
+					return nil, fmt.Errorf("line %d: missing value for %q", r.i, key)
+				}
+				// sequences may sit at their key's indent, but this writes them deeper.
+				v, err = r.block(indent + 2)
+			} else {
+				v, err = yamlScalar(rest)
+			}
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := m[key]; ok {
+				return nil, fmt.Errorf("line %d: duplicate key %q", r.i, key)
+			}
+			m[key] = v
+
+			if r.i == len(r.lines) || r.lines[r.i].indent != indent {
+				return m, nil
+			}
+			if key, rest, ok, err = cutYAMLKey(r.lines[r.i].text); err != nil || !ok {
+				return nil, fmt.Errorf("line %d: want key, got %q (%v)", r.i+1, r.lines[r.i].text, err)
+			}
+		}
+	}
+}
+
+// cutYAMLKey splits a mapping entry into its key and the rest of the line, if
+// it is one.
+func cutYAMLKey(text string) (key, rest string, ok bool, err error) {
+	var raw string
+	if strings.HasPrefix(text, `"`) {
+		dec := json.NewDecoder(strings.NewReader(text))
+		if err := dec.Decode(&key); err != nil {
+			return "", "", false, err
+		}
+		raw, rest = text[:dec.InputOffset()], text[dec.InputOffset():]
+	} else {
+		var found bool
+		if raw, rest, found = strings.Cut(text, ":"); !found {
+			return "", "", false, nil
+		}
+		rest = ":" + rest
+		key = raw
+	}
+
+	switch {
+	case rest == ":":
+		rest = ""
+	case strings.HasPrefix(rest, ": "):
+		rest = rest[2:]
+	case raw == text:
+		return "", "", false, nil // a quoted scalar.
+	default:
+		return "", "", false, fmt.Errorf("malformed entry %q", text)
+	}
+
+	if !strings.HasPrefix(raw, `"`) {
+		v, err := yamlScalar(raw)
+		if err != nil {
+			return "", "", false, err
+		}
+		if _, ok := v.(string); !ok {
+			return "", "", false, fmt.Errorf("key %q reads as %T", raw, v)
+		}
+	}
+	return key, rest, true, nil
+}
+
+var (
+	yaml11Null  = regexp.MustCompile(`^(~|null|Null|NULL|)$`)
+	yaml11True  = regexp.MustCompile(`^(y|Y|yes|Yes|YES|true|True|TRUE|on|On|ON)$`)
+	yaml11False = regexp.MustCompile(`^(n|N|no|No|NO|false|False|FALSE|off|Off|OFF)$`)
+	yaml11Int   = regexp.MustCompile(`^[-+]?(0|[1-9][0-9_]*|0b[01_]+|0[0-7_]+|0x[0-9a-fA-F_]+|[1-9][0-9_]*(:[0-5]?[0-9])+)$`)
+	yaml11Float = regexp.MustCompile(`^([-+]?([0-9][0-9_]*)?\.[0-9.]*([eE][-+][0-9]+)?|[-+]?[0-9][0-9_]*(:[0-5]?[0-9])+\.[0-9_]*|[-+]?\.(inf|Inf|INF)|\.(nan|NaN|NAN))$`)
+
+	// yamlIndicators start scalars that can't be written unquoted.
+	yamlIndicators = "-?:,[]{}#&*!|>'\"%@`"
+)
+
+// yamlScalar reads a scalar written on a single line.
+func yamlScalar(s string) (any, error) {
+	switch {
+	case strings.HasPrefix(s, `"`):
+		var v string
+		err := json.Unmarshal([]byte(s), &v)
+		return v, err
+	case s == "{}":
+		return map[string]any{}, nil
+	case s == "[]":
+		return []any{}, nil
+	case yaml11Null.MatchString(s):
+		return nil, nil
+	case yaml11True.MatchString(s):
+		return true, nil
+	case yaml11False.MatchString(s):
+		return false, nil
+	case yaml11Int.MatchString(s), yaml11Float.MatchString(s):
+		// only numbers json would write the same way are expected.
+		var f float64
+		if err := json.Unmarshal([]byte(s), &f); err != nil {
+			return nil, fmt.Errorf("%q reads as a number json wouldn't write", s)
+		}
+		return f, nil
+	case strings.ContainsAny(s[:1], yamlIndicators), strings.Contains(s, ": "), strings.Contains(s, " #"):
+		return nil, fmt.Errorf("%q must be quoted", s)
+	default:
+		return s, nil
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
@@ -18,10 +20,17 @@ import (
 	"github.com/nightmarlin/sweeper/gen/sweeper/v1/sweeperv1connect"
 	"github.com/nightmarlin/sweeper/handlers"
 	"github.com/nightmarlin/sweeper/infra/memory"
+	"github.com/nightmarlin/sweeper/lobby"
+	"github.com/nightmarlin/sweeper/tournament"
 )
 
 var (
 	port = flag.String("port", "34567", "port to listen on")
+
+	queueTimeout  = flag.Duration("queue-timeout", 2*time.Minute, "how long players may wait in the lobby before giving up")
+	spectateDelay = flag.Duration("spectate-delay", 0, "how long spectators must wait to see each move. they can only be kept from skipping it with -tokens")
+
+	tokensPath = flag.String("tokens", "", "JSON file mapping each player's bearer token to their id. if unset, players aren't authenticated")
 )
 
 func main() {
@@ -32,9 +41,29 @@ func main() {
 		mux         = http.NewServeMux()
 		srv         = &http.Server{Addr: fmt.Sprintf(":%s", *port), Handler: mux}
 		ctx, cancel = signal.NotifyContext(context.Background(), os.Interrupt, os.Kill)
+		store       = memory.NewStore()
+		svc         = sweeper.NewService(store, uuid.New, randv2.IntN, sweeper.SystemClock{})
+		lby         = lobby.New(svc, sweeper.SystemClock{}, *queueTimeout)
+		tournaments = tournament.New(svc, store, uuid.New, log)
 	)
 
 	defer cancel()
+
+	interceptors := []connect.Interceptor{LoggingInterceptor{logger: log}}
+	switch {
+	case *tokensPath == "" && *spectateDelay > 0:
+		log.Warn("players aren't authenticated, so spectators may skip the spectate delay by acting as a player")
+	case *tokensPath == "":
+		log.Warn("players aren't authenticated, so anyone may act as, and see the games of, any player")
+	default:
+		tokens, err := loadTokens(*tokensPath)
+		if err != nil {
+			log.Error("failed to load tokens", slog.String("error", err.Error()))
+			os.Exit(2)
+		}
+		interceptors = append(interceptors, handlers.NewAuth(tokens))
+	}
+
 	go func() {
 		<-ctx.Done()
 		_ = srv.Shutdown(ctx)
@@ -42,8 +71,8 @@ func main() {
 
 	mux.Handle(
 		sweeperv1connect.NewSweeperServiceHandler(
-			handlers.NewConnect(sweeper.NewService(memory.NewStore(), uuid.New, randv2.IntN)),
-			connect.WithInterceptors(LoggingInterceptor{logger: log}),
+			handlers.NewConnect(svc, lby, tournaments, *spectateDelay),
+			connect.WithInterceptors(interceptors...),
 		),
 	)
 
@@ -57,6 +86,20 @@ func main() {
 	}
 }
 
+// loadTokens reads the players' bearer tokens from the file at path, which maps
+// each token to its player's id.
+func loadTokens(path string) (map[string]sweeper.PlayerID, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var tokens map[string]sweeper.PlayerID
+	if err := json.Unmarshal(b, &tokens); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return tokens, nil
+}
+
 type LoggingInterceptor struct {
 	logger *slog.Logger
 }
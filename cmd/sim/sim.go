@@ -0,0 +1,132 @@
+// Command sim generates Boards in bulk and reports how fair they are: the
+// distribution of their 3BV and opening sizes, how many can be won without
+// guessing, and heatmaps of where mines and openings land.
+//
+// Usage
+//
+//	sim [-boards=<n>] [-seed=<n>] [-format=<csv|json>] [-solve=<true|false>] [-workers=<n>] [preset]...
+//
+// Presets are beginner, intermediate or expert, or a custom board given as
+// <height>x<width>/<mines>. All three standard presets are simulated if none
+// are given. Boards are generated the way the server generates them, from a
+// generator seeded with -seed, so runs with the same seed report the same
+// results.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	randv2 "math/rand/v2"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+
+	"github.com/nightmarlin/sweeper"
+	"github.com/nightmarlin/sweeper/sim"
+)
+
+var (
+	boards  = flag.Int("boards", 1_000_000, "number of boards to generate for each preset")
+	seed    = flag.Uint64("seed", 0, "seed for generating boards. if 0, a random seed is used")
+	format  = flag.String("format", "csv", "format to write results in: csv or json")
+	solve   = flag.Bool("solve", true, "check whether each board can be won without guessing")
+	workers = flag.Int("workers", 0, "number of boards to measure at once. if 0, one per cpu")
+)
+
+var standardPresets = []sim.Preset{
+	{Name: "beginner", Board: sweeper.Board{Height: 9, Width: 9, Mines: 10}},
+	{Name: "intermediate", Board: sweeper.Board{Height: 16, Width: 16, Mines: 40}},
+	{Name: "expert", Board: sweeper.Board{Height: 16, Width: 30, Mines: 99}},
+}
+
+func main() {
+	flag.Parse()
+
+	var (
+		log         = slog.New(slog.NewTextHandler(os.Stderr, nil))
+		ctx, cancel = signal.NotifyContext(context.Background(), os.Interrupt, os.Kill)
+	)
+	defer cancel()
+
+	write := sim.WriteCSV
+	switch *format {
+	case "csv":
+	case "json":
+		write = sim.WriteJSON
+	default:
+		log.Error("unknown format", slog.String("format", *format))
+		os.Exit(2)
+	}
+
+	presets := standardPresets
+	if flag.NArg() > 0 {
+		presets = make([]sim.Preset, 0, flag.NArg())
+		for _, arg := range flag.Args() {
+			p, err := parsePreset(arg)
+			if err != nil {
+				log.Error("invalid preset", slog.String("error", err.Error()))
+				os.Exit(2)
+			}
+			presets = append(presets, p)
+		}
+	}
+
+	if *seed == 0 {
+		*seed = randv2.Uint64()
+	}
+	log.Info("simulating", slog.Uint64("seed", *seed), slog.Int("boards", *boards))
+
+	reports, err := sim.Run(
+		ctx,
+		sim.Config{
+			Boards:    *boards,
+			NumberGen: sweeper.SeededNumberGenerator(*seed),
+			Solve:     *solve,
+			Workers:   *workers,
+		},
+		presets...,
+	)
+	if err != nil {
+		log.Error("failed to simulate", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	if err := write(os.Stdout, reports); err != nil {
+		log.Error("failed to write results", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+}
+
+// parsePreset parses the name of a standard preset, or a custom board given as
+// <height>x<width>/<mines>.
+func parsePreset(s string) (sim.Preset, error) {
+	for _, p := range standardPresets {
+		if p.Name == s {
+			return p, nil
+		}
+	}
+
+	size, mines, ok := strings.Cut(s, "/")
+	h, w, ok2 := strings.Cut(size, "x")
+	if !ok || !ok2 {
+		return sim.Preset{}, fmt.Errorf("%q is not a preset or <height>x<width>/<mines>", s)
+	}
+
+	var (
+		b   sweeper.Board
+		err error
+	)
+	if b.Height, err = strconv.Atoi(h); err != nil {
+		return sim.Preset{}, fmt.Errorf("parsing height: %w", err)
+	}
+	if b.Width, err = strconv.Atoi(w); err != nil {
+		return sim.Preset{}, fmt.Errorf("parsing width: %w", err)
+	}
+	if b.Mines, err = strconv.Atoi(mines); err != nil {
+		return sim.Preset{}, fmt.Errorf("parsing mine count: %w", err)
+	}
+	return sim.Preset{Name: s, Board: b}, nil
+}
@@ -0,0 +1,422 @@
+package sweeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChunkSize is the width and height of the Chunks an Endless board is made of.
+const ChunkSize = 16
+
+// chunkCells is the number of Cells in a Chunk.
+const chunkCells = ChunkSize * ChunkSize
+
+// The fewest and most mines a Chunk of an Endless board may hold. Sparser
+// boards could have empty regions that never end, and denser ones can rarely
+// be played.
+const (
+	MinMinesPerChunk = chunkCells / 8
+	MaxMinesPerChunk = chunkCells / 2
+)
+
+// MaxChunks is the most Chunks a player may touch in an Endless Game, which
+// bounds how much it takes to store.
+const MaxChunks = 1024
+
+// endlessOpening is the Cell revealed when an Endless Game starts. It is in
+// the middle of Chunk {0, 0}, so none of its neighbours are in other Chunks.
+var endlessOpening = CellRef{Row: ChunkSize / 2, Column: ChunkSize / 2}
+
+// EndlessHome is the Chunk holding the opening of an Endless Game: the Region
+// players see when they don't ask for another.
+var EndlessHome = Region{Rows: ChunkSize, Columns: ChunkSize}
+
+// A ChunkRef locates a Chunk of an Endless board. Chunk {0, 0} holds the Cells
+// from {0, 0} to {ChunkSize-1, ChunkSize-1}, and Chunks with negative
+// coordinates hold the Cells above and to the left of it.
+type ChunkRef struct{ Row, Column int }
+
+// ChunkOf returns the Chunk holding the Cell at ref, and the index of the Cell
+// within it, counting along each row. Unlike on Boards, the Cells of Endless
+// boards may have negative coordinates.
+func ChunkOf(ref CellRef) (ChunkRef, int) {
+	var (
+		c   = ChunkRef{Row: floorDiv(ref.Row, ChunkSize), Column: floorDiv(ref.Column, ChunkSize)}
+		row = ref.Row - c.Row*ChunkSize
+		col = ref.Column - c.Column*ChunkSize
+	)
+	return c, row*ChunkSize + col
+}
+
+// floorDiv divides a by b, rounding towards negative infinity.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// A Chunk holds the state of the Cells in a Chunk of an Endless board that the
+// player has touched. The mines in it are not stored: they are generated again
+// from the Game's seed whenever they are needed.
+type Chunk struct {
+	States [chunkCells]CellState
+}
+
+// An Endless Game is played on a board with no edges, which is generated one
+// Chunk at a time as the player explores it. It is never won: the player
+// scores a point for each safe Cell they reveal, until they reveal a mine or
+// end the Game.
+type Endless struct {
+	ID            uuid.UUID
+	State         GameState
+	Seed          uint64
+	MinesPerChunk int
+	StartedAt     time.Time
+
+	// Player is the only player who may make moves. If empty, anyone may.
+	Player PlayerID
+
+	// Cleared is the number of safe Cells revealed: the player's score.
+	Cleared int
+
+	// Chunks are the Chunks the player has changed any Cells in. Every other
+	// Chunk is untouched.
+	Chunks map[ChunkRef]*Chunk
+
+	// mines caches the mines generated for each Chunk.
+	mines map[ChunkRef]*[chunkCells]bool
+}
+
+// NewEndless creates an Endless Game with its opening revealed.
+func NewEndless(idGen IDGenerator, seed uint64, minesPerChunk int) (*Endless, error) {
+	if minesPerChunk < MinMinesPerChunk || MaxMinesPerChunk < minesPerChunk {
+		return nil, fmt.Errorf(
+			"%w: chunks must hold between %d and %d mines",
+			ErrOutOfBounds, MinMinesPerChunk, MaxMinesPerChunk,
+		)
+	}
+
+	e := &Endless{
+		ID:            idGen(),
+		State:         GameOngoing,
+		Seed:          seed,
+		MinesPerChunk: minesPerChunk,
+		Chunks:        make(map[ChunkRef]*Chunk),
+	}
+	// the opening floods a handful of Chunks at most, so can't reach the cap.
+	opened, _ := e.reveal(endlessOpening)
+	e.Cleared += opened
+	return e, nil
+}
+
+// Clone returns a deep copy of the Game.
+func (e *Endless) Clone() *Endless {
+	res := *e
+	res.Chunks = make(map[ChunkRef]*Chunk, len(e.Chunks))
+	for ref, c := range e.Chunks {
+		chunk := *c
+		res.Chunks[ref] = &chunk
+	}
+	// the cache is cheap to rebuild, and not sharing it keeps copies safe to
+	// use from different goroutines.
+	res.mines = nil
+	return &res
+}
+
+func (e *Endless) finished() bool { return e.State != GameOngoing }
+
+// CanMove reports whether the player may make moves in the Game.
+func (e *Endless) CanMove(player PlayerID) bool {
+	return e.Player == "" || e.Player == player
+}
+
+// At returns the Cell at ref.
+func (e *Endless) At(ref CellRef) Cell {
+	c := Cell{State: e.state(ref)}
+	if e.mine(ref) {
+		c.Mines = 1
+	}
+	if c.State == CellFlagged {
+		c.Flags = 1
+	}
+	for _, n := range TopologySquare.Neighbours(ref) {
+		if e.mine(n) {
+			c.NeighbouringMines++
+		}
+	}
+	return c
+}
+
+// View returns the Cells in the Region, along each row, holding only what the
+// player can see: Cells that have not been revealed never report their mines.
+func (e *Endless) View(r Region) ([]Cell, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	res := make([]Cell, r.Len())
+	for i := range res {
+		c := e.At(r.Ref(i))
+		if c.State != CellRevealed {
+			c.Mines, c.NeighbouringMines = 0, 0
+		}
+		res[i] = c
+	}
+	return res, nil
+}
+
+// UpdateCell tries to update the CellState of the Cell at ref, like
+// Game.UpdateCell. Revealing a mine loses the Game. It fails with
+// ErrOutOfBounds if the move would touch more than MaxChunks Chunks, which may
+// leave the Game partly changed.
+func (e *Endless) UpdateCell(ref CellRef, s CellState) error {
+	if e.finished() {
+		return ErrGameFinished
+	}
+
+	switch s {
+	case CellDefault, CellFlagged, CellQuestioned:
+		if e.state(ref) == CellRevealed {
+			return ErrRevealed
+		}
+		return e.setState(ref, s)
+
+	case CellRevealed:
+		if e.state(ref) == CellFlagged {
+			return ErrFlagged
+		}
+		opened, err := e.reveal(ref)
+		e.Cleared += opened
+		return err
+
+	default:
+		return fmt.Errorf("unknown action")
+	}
+}
+
+// Chord reveals every unflagged neighbour of the revealed Cell at ref, once as
+// many of them have been flagged as it has neighbouring mines, like
+// Game.Chord. Like UpdateCell, it fails if it would touch more than MaxChunks
+// Chunks.
+func (e *Endless) Chord(ref CellRef) error {
+	if e.finished() {
+		return ErrGameFinished
+	}
+	c := e.At(ref)
+	if c.State != CellRevealed || c.ContainsMine() {
+		return nil
+	}
+
+	var (
+		neighbours = TopologySquare.Neighbours(ref)
+		flags      int
+	)
+	for _, n := range neighbours {
+		if e.state(n) == CellFlagged {
+			flags++
+		}
+	}
+	if flags != c.NeighbouringMines {
+		return nil
+	}
+
+	for _, n := range neighbours {
+		if e.finished() {
+			break
+		}
+		if s := e.state(n); s != CellFlagged && s != CellRevealed {
+			opened, err := e.reveal(n)
+			e.Cleared += opened
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// End ends the Game, keeping the player's score.
+func (e *Endless) End() error {
+	if e.finished() {
+		return ErrGameFinished
+	}
+	e.State = GameResigned
+	return nil
+}
+
+// reveal reveals the Cell at ref, losing the Game if it holds a mine and
+// flooding outwards from it if it has no neighbouring mines, clearing any
+// flags in the way. It returns the number of safe Cells revealed, stopping
+// early if the flood would touch more than MaxChunks Chunks.
+func (e *Endless) reveal(ref CellRef) (opened int, err error) {
+	if e.state(ref) == CellRevealed {
+		return 0, nil
+	}
+	if err := e.setState(ref, CellRevealed); err != nil {
+		return 0, err
+	}
+	if e.mine(ref) {
+		e.State = GameLost
+		return 0, nil
+	}
+	opened++
+
+	stack := []CellRef{ref}
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if e.At(cur).NeighbouringMines != 0 {
+			continue
+		}
+
+		for _, n := range TopologySquare.Neighbours(cur) {
+			if e.state(n) == CellRevealed {
+				continue
+			}
+			if err := e.setState(n, CellRevealed); err != nil {
+				return opened, err
+			}
+			opened++
+			stack = append(stack, n)
+		}
+	}
+	return opened, nil
+}
+
+// state returns the CellState of the Cell at ref.
+func (e *Endless) state(ref CellRef) CellState {
+	c, i := ChunkOf(ref)
+	if chunk, ok := e.Chunks[c]; ok {
+		return chunk.States[i]
+	}
+	return CellDefault
+}
+
+// setState sets the CellState of the Cell at ref, touching its Chunk. It fails
+// with ErrOutOfBounds if MaxChunks Chunks have already been touched. Setting a
+// Cell in an untouched Chunk to CellDefault changes nothing, so doesn't touch
+// it.
+func (e *Endless) setState(ref CellRef, s CellState) error {
+	c, i := ChunkOf(ref)
+	chunk, ok := e.Chunks[c]
+	if !ok {
+		if s == CellDefault {
+			return nil
+		}
+		if len(e.Chunks) >= MaxChunks {
+			return fmt.Errorf("%w: games may only touch %d chunks", ErrOutOfBounds, MaxChunks)
+		}
+		chunk = &Chunk{}
+		e.Chunks[c] = chunk
+	}
+	chunk.States[i] = s
+	return nil
+}
+
+// mine reports whether the Cell at ref holds a mine.
+func (e *Endless) mine(ref CellRef) bool {
+	c, i := ChunkOf(ref)
+	mines, ok := e.mines[c]
+	if !ok {
+		mines = e.generate(c)
+		if e.mines == nil {
+			e.mines = make(map[ChunkRef]*[chunkCells]bool)
+		}
+		e.mines[c] = mines
+	}
+	return mines[i]
+}
+
+// generate places the mines in the Chunk, the same way for the same seed every
+// time. Like NewGame, it uses a partial Fisher-Yates shuffle, but of a
+// NumberGenerator seeded from both the Game's seed and the Chunk, so Chunks can
+// be generated in any order.
+func (e *Endless) generate(c ChunkRef) *[chunkCells]bool {
+	var (
+		mines     [chunkCells]bool
+		numberGen = rand.New(rand.NewPCG(e.Seed, uint64(uint32(c.Row))<<32|uint64(uint32(c.Column)))).IntN
+		free      = make([]int, 0, chunkCells)
+	)
+	for i := range chunkCells {
+		ref := CellRef{Row: c.Row*ChunkSize + i/ChunkSize, Column: c.Column*ChunkSize + i%ChunkSize}
+		// the opening and its neighbours are always safe, so the Game starts
+		// with an empty region to explore from.
+		if abs(ref.Row-endlessOpening.Row) <= 1 && abs(ref.Column-endlessOpening.Column) <= 1 {
+			continue
+		}
+		free = append(free, i)
+	}
+
+	for range e.MinesPerChunk {
+		i := numberGen(len(free))
+		mines[free[i]] = true
+		free[i] = free[len(free)-1]
+		free = free[:len(free)-1]
+	}
+	return &mines
+}
+
+func abs(n int) int { return max(n, -n) }
+
+// StartEndless creates a new Endless Game with a random seed. If player is
+// set, only they may make moves.
+func (s Service) StartEndless(ctx context.Context, player PlayerID, minesPerChunk int) (*Endless, error) {
+	e, err := NewEndless(s.idGen, uint64(s.numberGen(math.MaxInt)), minesPerChunk)
+	if err != nil {
+		return nil, fmt.Errorf("creating game: %w", err)
+	}
+	e.Player = player
+	e.StartedAt = s.clock.Now()
+
+	if err := s.store.SaveEndless(ctx, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (s Service) GetEndless(ctx context.Context, gameID uuid.UUID) (*Endless, error) {
+	return s.store.GetEndless(ctx, gameID)
+}
+
+// MakeEndlessMove updates the Cell at ref on behalf of the player.
+func (s Service) MakeEndlessMove(
+	ctx context.Context,
+	gameID uuid.UUID,
+	player PlayerID,
+	m CellMove,
+) (*Endless, error) {
+	return s.store.MutateEndless(
+		ctx,
+		gameID,
+		func(_ context.Context, e *Endless) error {
+			if !e.CanMove(player) {
+				return ErrNotAPlayer
+			}
+			if m.Chord {
+				return e.Chord(m.Ref)
+			}
+			return e.UpdateCell(m.Ref, m.State)
+		},
+	)
+}
+
+// EndEndless ends the Game on behalf of the player.
+func (s Service) EndEndless(ctx context.Context, gameID uuid.UUID, player PlayerID) (*Endless, error) {
+	return s.store.MutateEndless(
+		ctx,
+		gameID,
+		func(_ context.Context, e *Endless) error {
+			if !e.CanMove(player) {
+				return ErrNotAPlayer
+			}
+			return e.End()
+		},
+	)
+}
@@ -0,0 +1,221 @@
+package sweeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+	"github.com/nightmarlin/sweeper/infra/memory"
+)
+
+func TestChunkOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ref   sweeper.CellRef
+		chunk sweeper.ChunkRef
+		index int
+	}{
+		{ref: sweeper.CellRef{Row: 0, Column: 0}, chunk: sweeper.ChunkRef{}, index: 0},
+		{ref: sweeper.CellRef{Row: 1, Column: 15}, chunk: sweeper.ChunkRef{}, index: 31},
+		{ref: sweeper.CellRef{Row: 16, Column: 33}, chunk: sweeper.ChunkRef{Row: 1, Column: 2}, index: 1},
+		{ref: sweeper.CellRef{Row: -1, Column: -1}, chunk: sweeper.ChunkRef{Row: -1, Column: -1}, index: 255},
+		{ref: sweeper.CellRef{Row: -16, Column: -17}, chunk: sweeper.ChunkRef{Row: -1, Column: -2}, index: 15},
+	}
+	for _, tt := range tests {
+		chunk, index := sweeper.ChunkOf(tt.ref)
+		if chunk != tt.chunk || index != tt.index {
+			t.Errorf("%v: want %v, %d, got %v, %d", tt.ref, tt.chunk, tt.index, chunk, index)
+		}
+	}
+}
+
+// mineIn returns a Cell in the Region holding a mine.
+func mineIn(t *testing.T, e *sweeper.Endless, r sweeper.Region) sweeper.CellRef {
+	t.Helper()
+	for i := range r.Len() {
+		if e.At(r.Ref(i)).ContainsMine() {
+			return r.Ref(i)
+		}
+	}
+	t.Fatalf("no mines in %v", r)
+	return sweeper.CellRef{}
+}
+
+func TestEndless(t *testing.T) {
+	t.Parallel()
+
+	far := sweeper.Region{Top: -48, Left: 64, Rows: sweeper.ChunkSize, Columns: sweeper.ChunkSize}
+
+	t.Run("chunks are generated from the seed", func(t *testing.T) {
+		t.Parallel()
+
+		a, err := sweeper.NewEndless(uuid.New, 1, sweeper.MinMinesPerChunk)
+		if err != nil {
+			t.Fatalf("creating game: %v", err)
+		}
+		b, _ := sweeper.NewEndless(uuid.New, 1, sweeper.MinMinesPerChunk)
+		c, _ := sweeper.NewEndless(uuid.New, 2, sweeper.MinMinesPerChunk)
+
+		var mines, differ int
+		for i := range far.Len() {
+			ref := far.Ref(i)
+			if a.At(ref).ContainsMine() {
+				mines++
+			}
+			if a.At(ref) != b.At(ref) {
+				t.Fatalf("%v: same seed generated different cells", ref)
+			}
+			if a.At(ref) != c.At(ref) {
+				differ++
+			}
+		}
+		if mines != sweeper.MinMinesPerChunk {
+			t.Errorf("want %d mines in chunk, got %d", sweeper.MinMinesPerChunk, mines)
+		}
+		if differ == 0 {
+			t.Error("different seeds generated the same chunk")
+		}
+	})
+
+	t.Run("only touched chunks are kept", func(t *testing.T) {
+		t.Parallel()
+
+		e, err := sweeper.NewEndless(uuid.New, 1, sweeper.MaxMinesPerChunk)
+		if err != nil {
+			t.Fatalf("creating game: %v", err)
+		}
+		if e.Cleared < 9 {
+			t.Errorf("want opening to clear at least 9 cells, got %d", e.Cleared)
+		}
+
+		ref := mineIn(t, e, far)
+		if err := e.UpdateCell(ref, sweeper.CellFlagged); err != nil {
+			t.Fatalf("flagging mine: %v", err)
+		}
+		// clearing a cell that was never marked doesn't touch its chunk.
+		if err := e.UpdateCell(sweeper.CellRef{Row: -1024, Column: -1024}, sweeper.CellDefault); err != nil {
+			t.Fatalf("clearing untouched cell: %v", err)
+		}
+		clone := e.Clone()
+		if len(clone.Chunks) != 2 {
+			t.Errorf("want 2 touched chunks, got %d", len(clone.Chunks))
+		}
+		if clone.At(ref) != e.At(ref) {
+			t.Errorf("clone lost flag at %v", ref)
+		}
+
+		view, err := clone.View(far)
+		if err != nil {
+			t.Fatalf("viewing region: %v", err)
+		}
+		for i, c := range view {
+			if c.Mines != 0 || c.NeighbouringMines != 0 {
+				t.Fatalf("%v: unrevealed cell shows its mines", far.Ref(i))
+			}
+			if far.Ref(i) == ref && c.State != sweeper.CellFlagged {
+				t.Errorf("want flagged cell at %v, got %v", ref, c.State)
+			}
+		}
+	})
+
+	t.Run("revealing a mine ends the game", func(t *testing.T) {
+		t.Parallel()
+
+		e, err := sweeper.NewEndless(uuid.New, 1, sweeper.MaxMinesPerChunk)
+		if err != nil {
+			t.Fatalf("creating game: %v", err)
+		}
+		cleared := e.Cleared
+
+		if err := e.UpdateCell(mineIn(t, e, far), sweeper.CellRevealed); err != nil {
+			t.Fatalf("revealing mine: %v", err)
+		}
+		if e.State != sweeper.GameLost {
+			t.Errorf("want state %v, got %v", sweeper.GameLost, e.State)
+		}
+		if e.Cleared != cleared {
+			t.Errorf("want score %d, got %d", cleared, e.Cleared)
+		}
+		if err := e.UpdateCell(sweeper.CellRef{}, sweeper.CellRevealed); !errors.Is(err, sweeper.ErrGameFinished) {
+			t.Errorf("want %v, got %v", sweeper.ErrGameFinished, err)
+		}
+	})
+
+	t.Run("touched chunks are capped", func(t *testing.T) {
+		t.Parallel()
+
+		e, err := sweeper.NewEndless(uuid.New, 1, sweeper.MinMinesPerChunk)
+		if err != nil {
+			t.Fatalf("creating game: %v", err)
+		}
+
+		// flag a cell in a new chunk, further and further above the opening.
+		chunk := func(i int) sweeper.CellRef { return sweeper.CellRef{Row: -sweeper.ChunkSize * (i + 1)} }
+		for i := 0; len(e.Chunks) < sweeper.MaxChunks; i++ {
+			if err := e.UpdateCell(chunk(i), sweeper.CellFlagged); err != nil {
+				t.Fatalf("flagging cell in chunk %d: %v", i, err)
+			}
+		}
+
+		next := chunk(sweeper.MaxChunks)
+		for _, s := range []sweeper.CellState{sweeper.CellFlagged, sweeper.CellRevealed} {
+			if err := e.UpdateCell(next, s); !errors.Is(err, sweeper.ErrOutOfBounds) {
+				t.Errorf("moving to %v in another chunk: want %v, got %v", s, sweeper.ErrOutOfBounds, err)
+			}
+		}
+		// clearing a cell that was never marked changes nothing, so is allowed.
+		if err := e.UpdateCell(next, sweeper.CellDefault); err != nil {
+			t.Errorf("clearing cell in another chunk: %v", err)
+		}
+		if len(e.Chunks) != sweeper.MaxChunks {
+			t.Errorf("want %d touched chunks, got %d", sweeper.MaxChunks, len(e.Chunks))
+		}
+
+		// chunks already touched can still be played in.
+		if err := e.UpdateCell(chunk(0), sweeper.CellDefault); err != nil {
+			t.Errorf("clearing flag: %v", err)
+		}
+	})
+}
+
+func TestService_endless(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc := sweeper.NewService(memory.NewStore(), uuid.New, sequence(7), newFakeClock())
+
+	if _, err := svc.StartEndless(ctx, "alice", sweeper.MaxMinesPerChunk+1); !errors.Is(err, sweeper.ErrOutOfBounds) {
+		t.Errorf("too many mines: want %v, got %v", sweeper.ErrOutOfBounds, err)
+	}
+
+	e, err := svc.StartEndless(ctx, "alice", sweeper.MinMinesPerChunk)
+	if err != nil {
+		t.Fatalf("starting game: %v", err)
+	}
+
+	move := sweeper.CellMove{Ref: sweeper.CellRef{Row: -100, Column: -100}, State: sweeper.CellFlagged}
+	if _, err := svc.MakeEndlessMove(ctx, e.ID, "bob", move); !errors.Is(err, sweeper.ErrNotAPlayer) {
+		t.Errorf("moving as another player: want %v, got %v", sweeper.ErrNotAPlayer, err)
+	}
+	if _, err := svc.MakeEndlessMove(ctx, e.ID, "alice", move); err != nil {
+		t.Fatalf("making move: %v", err)
+	}
+
+	if _, err := svc.EndEndless(ctx, e.ID, "alice"); err != nil {
+		t.Fatalf("ending game: %v", err)
+	}
+	got, err := svc.GetEndless(ctx, e.ID)
+	if err != nil {
+		t.Fatalf("getting game: %v", err)
+	}
+	if got.State != sweeper.GameResigned || got.Cleared != e.Cleared {
+		t.Errorf("want state %v with score %d, got %v with %d", sweeper.GameResigned, e.Cleared, got.State, got.Cleared)
+	}
+	if got.At(move.Ref).State != sweeper.CellFlagged {
+		t.Errorf("want flag at %v to be kept", move.Ref)
+	}
+}
@@ -3,6 +3,8 @@ package sweeper
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -14,18 +16,128 @@ const (
 	GameWon                        // The player won the Game.
 	GameLost                       // The player lost the Game.
 	GameResigned                   // The player chose to end the Game.
+	GameTimedOut                   // The player ran out of time.
+	GameDrawn                      // Under RulesFlags, the leading Players tied.
+)
+
+// Rules decide what happens when a mine is revealed, and how a Game is won.
+type Rules int
+
+const (
+	// RulesClassic loses the Game when a mine is revealed. It is won by
+	// revealing every safe Cell, or flagging every mine.
+	RulesClassic = Rules(iota)
+
+	// RulesFlags is played by Players taking turns to reveal Cells. Revealing a
+	// mine claims it, scoring a point per mine and keeping the turn. Revealing a
+	// safe Cell passes the turn to the next Player. The Game is won by the first
+	// Player to claim a majority of the mines.
+	RulesFlags
 )
 
 type Board struct {
 	Width, Height int
 	Mines         int
+	Topology      Topology
+	Rules         Rules
+
+	// Depth is the number of layers the Board has. Cells neighbour the Cells in
+	// the layers directly above and below them, as well as those in their own
+	// layer. If zero, the Board has 1 layer.
+	Depth int
+
+	// Wrap joins opposite edges of the Board together, so Cells on one edge
+	// neighbour the Cells on the other.
+	Wrap bool
+
+	// MaxMinesPerCell is how many mines a single Cell may hold. If zero, each
+	// Cell holds at most 1 mine.
+	MaxMinesPerCell int
+
+	// TimeLimit is how long the player has to finish the Game. If zero, the Game
+	// is untimed.
+	TimeLimit time.Duration
+}
+
+// depth returns the number of layers the Board has.
+func (b Board) depth() int { return max(b.Depth, 1) }
+
+// size returns the number of Cells on the Board.
+func (b Board) size() int { return b.Width * b.Height * b.depth() }
+
+// ref returns the i'th Cell on the Board, counting along each row, then down
+// each layer.
+func (b Board) ref(i int) CellRef {
+	return CellRef{Layer: i / (b.Width * b.Height), Row: i / b.Width % b.Height, Column: i % b.Width}
+}
+
+// index returns where the Cell at ref is counted by ref.
+func (b Board) index(ref CellRef) int {
+	return (ref.Layer*b.Height+ref.Row)*b.Width + ref.Column
+}
+
+// maxMinesPerCell returns how many mines a single Cell may hold.
+func (b Board) maxMinesPerCell() int { return max(b.MaxMinesPerCell, 1) }
+
+// Contains reports whether the Cell at ref is on the Board.
+func (b Board) Contains(ref CellRef) bool {
+	return 0 <= ref.Row && ref.Row < b.Height &&
+		0 <= ref.Column && ref.Column < b.Width &&
+		0 <= ref.Layer && ref.Layer < b.depth()
 }
 
-// safeCells returns the number of Cells that don't contain a mine.
-func (b Board) safeCells() int {
-	return (b.Width * b.Height) - b.Mines
+// Neighbours returns the CellRefs of every Cell on the Board neighbouring the
+// Cell at ref, according to the Board's Topology and Depth. If the Board wraps,
+// Cells past an edge are looked up on the opposite edge.
+func (b Board) Neighbours(ref CellRef) []CellRef {
+	return b.appendNeighbours(nil, ref)
+}
+
+// appendNeighbours appends the neighbours of the Cell at ref to dst, so that
+// callers walking a whole Board can reuse one slice.
+func (b Board) appendNeighbours(dst []CellRef, ref CellRef) []CellRef {
+	start := len(dst)
+	dst = b.Topology.appendNeighbours(dst, ref)
+	if b.depth() > 1 {
+		// the layers above and below contain the Cell's own position as well as
+		// the positions of its neighbours.
+		inLayer := len(dst)
+		for _, dl := range []int{-1, 1} {
+			dst = append(dst, CellRef{Layer: ref.Layer + dl, Row: ref.Row, Column: ref.Column})
+			for _, cr := range dst[start:inLayer] {
+				dst = append(dst, CellRef{Layer: cr.Layer + dl, Row: cr.Row, Column: cr.Column})
+			}
+		}
+	}
+
+	res := dst[start:]
+	n := 0
+	for _, cr := range res {
+		if b.Wrap {
+			cr = CellRef{
+				Layer:  mod(cr.Layer, b.depth()),
+				Row:    mod(cr.Row, b.Height),
+				Column: mod(cr.Column, b.Width),
+			}
+
+			// on small boards, wrapping can lead back to the Cell itself or to a
+			// neighbour that has already been seen.
+			if cr == ref || slices.Contains(res[:n], cr) {
+				continue
+			}
+		}
+
+		if b.Contains(cr) {
+			res[n] = cr
+			n++
+		}
+	}
+	return dst[:start+n]
 }
 
+// mod returns a modulo b, in the range [0, b).
+func mod(a, b int) int { return ((a % b) + b) % b }
+
 type CellState int
 
 const (
@@ -36,20 +148,61 @@ const (
 )
 
 type CellRef struct {
+	Layer       int // Always 0 unless the Board has a Depth.
 	Row, Column int
 }
 
 type Cell struct {
-	ContainsMine      bool
-	NeighbouringMines int
+	Mines             int // The number of mines in the Cell.
+	NeighbouringMines int // The total number of mines in the Cell's neighbours.
 	State             CellState
+	Flags             int // The number of flags placed on the Cell while it is CellFlagged.
 }
 
+func (c Cell) ContainsMine() bool { return c.Mines > 0 }
+
 type Game struct {
-	ID    uuid.UUID
-	State GameState
-	Board Board
-	Cells map[CellRef]Cell
+	ID        uuid.UUID
+	State     GameState
+	Board     Board
+	Cells     Cells
+	StartedAt time.Time
+	// Opening is the Cell revealed when the Game was created, if any.
+	Opening *CellRef
+
+	// Players are the players taking part in the Game, in the order they
+	// joined. If there are none, anyone may make moves.
+	Players []Player
+	// Invited are the players that have been invited to join the Game, but have
+	// not done so yet.
+	Invited []PlayerID
+	// Moves are the moves applied to the Game's Cells, in the order they were
+	// made.
+	Moves []Move
+
+	// MatchID is the ID of the Match the Game is being played in, if any.
+	MatchID uuid.UUID
+
+	// Turn is the index in Players of the Player who must make the next move.
+	// It is only used by RulesFlags.
+	Turn int
+	// Winner is the Player who won a RulesFlags Game. It is empty if the Game is
+	// ongoing, or ended in a draw.
+	Winner PlayerID
+}
+
+// Clone returns a deep copy of the Game.
+func (g *Game) Clone() *Game {
+	res := *g
+	res.Cells = g.Cells.Clone()
+	res.Players = slices.Clone(g.Players)
+	res.Invited = slices.Clone(g.Invited)
+	res.Moves = slices.Clone(g.Moves)
+	if g.Opening != nil {
+		opening := *g.Opening
+		res.Opening = &opening
+	}
+	return &res
 }
 
 // An IDGenerator generates globally unique IDs.
@@ -63,163 +216,265 @@ type IDGenerator func() uuid.UUID
 // > This type is compatible with rand.IntN.
 type NumberGenerator func(n int) int
 
+// A Clock tells the time, and schedules functions to be run in the future.
+type Clock interface {
+	Now() time.Time
+	// AfterFunc calls f in its own goroutine once d has elapsed. Calling stop
+	// before then prevents f from being called, and reports whether it did so.
+	AfterFunc(d time.Duration, f func()) (stop func() bool)
+}
+
+// SystemClock is a Clock backed by the time package.
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time { return time.Now() }
+
+func (SystemClock) AfterFunc(d time.Duration, f func()) func() bool {
+	return time.AfterFunc(d, f).Stop
+}
+
+// GeneratorVersion identifies how NewGame places the opening and mines. The
+// same seed only generates the same Board under the same version, so it is
+// bumped whenever placement changes. Version 1 placed mines by rejection
+// sampling, before the shuffle used now.
+const GeneratorVersion = 2
+
 func NewGame(
 	ctx context.Context,
 	idGen IDGenerator,
 	numberGen NumberGenerator,
 	board Board,
 ) (*Game, error) {
-	boardSize := board.Height * board.Width
-	switch {
-	case board.Height <= 0, board.Width <= 0:
-		return nil, fmt.Errorf(
-			"%w: invalid board dimensions (%dx%d)",
-			ErrOutOfBounds, board.Height, board.Width,
-		)
-	case board.Mines <= 0:
-		return nil, fmt.Errorf("%w: board must have at least 1 mine", ErrOutOfBounds)
-	case board.Mines >= boardSize:
-		return nil, fmt.Errorf("%w: board must have at least 1 free space", ErrOutOfBounds)
+	if err := board.validate(); err != nil {
+		return nil, err
 	}
 
-	g := Game{
-		ID:    idGen(),
-		State: GameOngoing,
-		Board: board,
-		Cells: make(map[CellRef]Cell, board.Height*board.Width),
+	g := newGame(idGen, board)
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
 
-	for row := range board.Height {
-		for col := range board.Width {
-			g.Cells[CellRef{Row: row, Column: col}] = Cell{}
-		}
+	// the opening and mines are picked by a partial Fisher-Yates shuffle of the
+	// Cells with room for another mine, so dense Boards take no longer than
+	// sparse ones. picking the opening first makes sure it is safe even when the
+	// mines would fill every other Cell, and on Boards of one mine per Cell is
+	// no different to picking it from the safe Cells afterwards.
+	free := make([]int, board.size())
+	for i := range free {
+		free[i] = i
+	}
+	take := func(i int) {
+		free[i] = free[len(free)-1]
+		free = free[:len(free)-1]
 	}
 
-	for range board.Mines {
-		for {
-			// this may take a while due to RNG so allow it to be cancelled.
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			default:
-			}
-
-			ref := CellRef{Row: numberGen(board.Height), Column: numberGen(board.Width)}
+	i := numberGen(len(free))
+	opening := board.ref(free[i])
+	take(i)
 
-			c := g.Cells[ref]
-			if c.ContainsMine {
-				// don't add a mine if one already present
-				continue
-			}
-			c.ContainsMine = true
-			g.Cells[ref] = c
-			break
+	for range board.Mines {
+		i := numberGen(len(free))
+		ref := board.ref(free[i])
+
+		c := g.Cells.At(ref)
+		c.Mines++
+		g.Cells.Set(ref, c)
+		if c.Mines >= board.maxMinesPerCell() {
+			take(i)
 		}
 	}
 
-	for cr, c := range g.Cells {
-		c.NeighbouringMines = g.countNeighbouringMines(cr)
-		g.Cells[cr] = c
-	}
+	g.countMines()
+	g.revealCell(opening)
+	g.Opening = &opening
+	g.tryWin()
 
-	for {
-		ref := CellRef{Row: numberGen(board.Height), Column: numberGen(board.Width)}
-		if g.Cells[ref].ContainsMine {
-			continue
-		}
-		g.revealCell(ref)
-		break
+	return g, nil
+}
+
+// validate checks the Board describes a playable Game.
+func (b Board) validate() error {
+	boardSize := b.size()
+	switch {
+	case b.Height <= 0, b.Width <= 0, b.Depth < 0:
+		return fmt.Errorf(
+			"%w: invalid board dimensions (%dx%dx%d)",
+			ErrOutOfBounds, b.Height, b.Width, b.Depth,
+		)
+	case b.Mines <= 0:
+		return fmt.Errorf("%w: board must have at least 1 mine", ErrOutOfBounds)
+	case b.MaxMinesPerCell < 0:
+		return fmt.Errorf("%w: cells must be able to hold at least 1 mine", ErrOutOfBounds)
+	case b.Mines > (boardSize-1)*b.maxMinesPerCell():
+		return fmt.Errorf("%w: board must have at least 1 free space", ErrOutOfBounds)
+	case b.Topology < TopologySquare || TopologyTriangle < b.Topology:
+		return fmt.Errorf("%w: unknown board topology (%d)", ErrOutOfBounds, b.Topology)
+	case b.Wrap && b.Topology == TopologyHex && b.Height%2 != 0:
+		return fmt.Errorf("%w: wrapping hex boards must have an even height", ErrOutOfBounds)
+	case b.Wrap && b.Topology == TopologyTriangle && (b.Height%2 != 0 || b.Width%2 != 0):
+		return fmt.Errorf(
+			"%w: wrapping triangle boards must have an even height and width", ErrOutOfBounds,
+		)
+	case b.Rules < RulesClassic || RulesFlags < b.Rules:
+		return fmt.Errorf("%w: unknown rules (%d)", ErrOutOfBounds, b.Rules)
+	case b.TimeLimit < 0:
+		return fmt.Errorf("%w: time limit must not be negative", ErrOutOfBounds)
 	}
 
-	return &g, nil
+	return nil
+}
+
+// newGame creates an ongoing Game on the Board, with no mines.
+func newGame(idGen IDGenerator, board Board) *Game {
+	return &Game{
+		ID:    idGen(),
+		State: GameOngoing,
+		Board: board,
+		Cells: NewCells(board),
+	}
 }
 
-// countNeighbouringMines counts the number of mines in the cells surrounding
-// the Cell at CellRef.
-func (g *Game) countNeighbouringMines(ref CellRef) (n int) {
-	for row := -1; row <= 1; row++ {
-		for col := -1; col <= 1; col++ {
-			if row == 0 && col == 0 {
-				continue
-			}
+// countMines sets the NeighbouringMines of every Cell in the Game.
+func (g *Game) countMines() {
+	var neighbours []CellRef
+	for i := range g.Cells.cells {
+		neighbours = g.Board.appendNeighbours(neighbours[:0], g.Board.ref(i))
 
-			lookup := CellRef{Row: ref.Row + row, Column: ref.Column + col}
-			if g.Cells[lookup].ContainsMine {
-				n++
-			}
+		n := 0
+		for _, cr := range neighbours {
+			n += g.Cells.At(cr).Mines
 		}
+		g.Cells.cells[i].NeighbouringMines = n
 	}
+}
+
+func (g *Game) checkBounds(ref CellRef) bool { return g.Board.Contains(ref) }
+
+func (g *Game) finished() bool { return g.State != GameOngoing }
 
-	return n
+// Deadline returns the time by which the Game must be finished. If the Game is
+// untimed, ok is false.
+func (g *Game) Deadline() (deadline time.Time, ok bool) {
+	if g.Board.TimeLimit <= 0 {
+		return time.Time{}, false
+	}
+	return g.StartedAt.Add(g.Board.TimeLimit), true
 }
 
-func (g *Game) checkBounds(ref CellRef) bool {
-	return 0 <= ref.Row && ref.Row < g.Board.Height &&
-		0 <= ref.Column && ref.Column < g.Board.Width
+// Remaining returns how long the player has left to finish the Game at now. If
+// the Game is untimed, ok is false.
+func (g *Game) Remaining(now time.Time) (remaining time.Duration, ok bool) {
+	deadline, ok := g.Deadline()
+	if !ok {
+		return 0, false
+	}
+	if g.finished() || !now.Before(deadline) {
+		return 0, true
+	}
+	return deadline.Sub(now), true
 }
 
-func (g *Game) finished() bool { return g.State != GameOngoing }
+// Expire sets the Game.State to GameTimedOut if the Game is still ongoing and
+// its deadline has passed at now, reporting whether it did so.
+func (g *Game) Expire(now time.Time) bool {
+	deadline, ok := g.Deadline()
+	if !ok || g.finished() || now.Before(deadline) {
+		return false
+	}
+	g.State = GameTimedOut
+	return true
+}
 
 func (g *Game) tryWin() {
 	// victory is obtained by revealing all non-mine squares or flagging all mines.
-	if g.finished() {
+	if g.finished() || g.Board.Rules != RulesClassic {
 		return
 	}
 
 	var (
-		cellsFlaggedTotal   int
-		cellsFlaggedCorrect int
+		flagsTotal   int
+		flagsCorrect int
 
+		cellsSafe     int
 		cellsRevealed int
 	)
 
-	for _, c := range g.Cells {
+	for _, c := range g.Cells.cells {
 		if c.State == CellFlagged {
-			cellsFlaggedTotal++
-			if c.ContainsMine {
-				cellsFlaggedCorrect++
+			flagsTotal += c.Flags
+			if c.Flags == c.Mines {
+				flagsCorrect += c.Flags
 			}
 		}
+		if !c.ContainsMine() {
+			cellsSafe++
+		}
 		if c.State == CellRevealed {
 			cellsRevealed++
 		}
 	}
 
-	if cellsFlaggedTotal == cellsFlaggedCorrect &&
-		cellsFlaggedCorrect == g.Board.Mines {
+	if flagsTotal == flagsCorrect &&
+		flagsCorrect == g.Board.Mines {
 		g.State = GameWon
 
-	} else if cellsRevealed == g.Board.safeCells() {
+	} else if cellsRevealed == cellsSafe {
 		g.State = GameWon
 	}
 }
 
 // reveals the Cell. if Cell is flagged, block. if Cell contains a mine, lose.
 // if Cell has no neighbouring mines, reveal all neighbours.
-func (g *Game) revealCell(ref CellRef) {
-	c := g.Cells[ref]
+// returns the number of safe Cells revealed.
+func (g *Game) revealCell(ref CellRef) (opened int) {
+	c := g.Cells.At(ref)
 	if c.State == CellRevealed {
-		return
+		return 0
 	}
 
 	c.State = CellRevealed
-	if c.ContainsMine {
-		g.State = GameLost
-		return
+	c.Flags = 0
+	g.Cells.Set(ref, c)
+	if c.ContainsMine() {
+		// under RulesFlags, the mine is claimed instead.
+		if g.Board.Rules == RulesClassic {
+			g.State = GameLost
+		}
+		return 0
+	}
+	opened++
+
+	// reveal neighbours if empty. the neighbours of an empty Cell never contain
+	// mines. a stack is used rather than recursion, which could overflow on huge
+	// empty regions.
+	if c.NeighbouringMines != 0 {
+		return opened
 	}
-	g.Cells[ref] = c
-
-	// reveal neighbours if empty
-	if c.NeighbouringMines == 0 {
-		for r := -1; r <= 1; r++ {
-			for col := -1; col <= 1; col++ {
-				cr := CellRef{Row: ref.Row + r, Column: ref.Column + col}
-				if c, ok := g.Cells[cr]; ok && c.State != CellRevealed {
-					g.revealCell(cr) // recursive reveal
-				}
+	var (
+		stack      = []CellRef{ref}
+		neighbours []CellRef
+	)
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		neighbours = g.Board.appendNeighbours(neighbours[:0], cur)
+		for _, cr := range neighbours {
+			nc := g.Cells.At(cr)
+			if nc.State == CellRevealed {
+				continue
+			}
+			nc.State = CellRevealed
+			nc.Flags = 0
+			g.Cells.Set(cr, nc)
+			opened++
+
+			if nc.NeighbouringMines == 0 {
+				stack = append(stack, cr)
 			}
 		}
 	}
+	return opened
 }
 
 // UpdateCell tries to update the CellState of the Cell at CellRef to the
@@ -228,36 +483,59 @@ func (g *Game) revealCell(ref CellRef) {
 // If the game is won or lost after this, Game.State will be set to GameWon or
 // GameLost accordingly.
 func (g *Game) UpdateCell(ref CellRef, s CellState) error {
+	_, err := g.updateCell(ref, s)
+	return err
+}
+
+// updateCell implements UpdateCell, additionally returning the number of safe
+// Cells revealed.
+func (g *Game) updateCell(ref CellRef, s CellState) (opened int, err error) {
 	if g.finished() {
-		return ErrGameFinished
+		return 0, ErrGameFinished
 	}
 
 	if !g.checkBounds(ref) {
-		return ErrOutOfBounds
+		return 0, ErrOutOfBounds
 	}
 
 	switch s {
 	case CellDefault, CellFlagged, CellQuestioned:
 		// set cell to have new marking. if revealed, block.
-		c := g.Cells[ref]
+		c := g.Cells.At(ref)
 		if c.State == CellRevealed {
-			return ErrRevealed
+			return 0, ErrRevealed
+		}
+
+		// flagging a flagged cell adds another flag, up to the most mines it
+		// could hold.
+		switch {
+		case s != CellFlagged:
+			c.Flags = 0
+		case c.State != CellFlagged:
+			c.Flags = 1
+		case c.Flags < g.Board.maxMinesPerCell():
+			c.Flags++
 		}
+
 		c.State = s
-		g.Cells[ref] = c
+		g.Cells.Set(ref, c)
 
 	case CellRevealed:
-		if g.Cells[ref].State == CellFlagged {
-			return ErrFlagged
+		switch g.Cells.At(ref).State {
+		case CellFlagged:
+			return 0, ErrFlagged
+		case CellRevealed:
+			// under RulesFlags, revealing a claimed mine again mustn't claim it twice.
+			return 0, ErrRevealed
 		}
-		g.revealCell(ref)
+		opened = g.revealCell(ref)
 
 	default:
-		return fmt.Errorf("unknown action")
+		return 0, fmt.Errorf("unknown action")
 	}
 
 	g.tryWin()
-	return nil
+	return opened, nil
 }
 
 func (g *Game) End() error {
@@ -269,9 +547,19 @@ func (g *Game) End() error {
 }
 
 var (
-	ErrGameNotFound = fmt.Errorf("game not found")
-	ErrRevealed     = fmt.Errorf("cell is already revealed")
-	ErrFlagged      = fmt.Errorf("cell is flagged")
-	ErrOutOfBounds  = fmt.Errorf("selection is out of bounds")
-	ErrGameFinished = fmt.Errorf("game is finished")
+	ErrGameNotFound  = fmt.Errorf("game not found")
+	ErrRevealed      = fmt.Errorf("cell is already revealed")
+	ErrFlagged       = fmt.Errorf("cell is flagged")
+	ErrOutOfBounds   = fmt.Errorf("selection is out of bounds")
+	ErrGameFinished  = fmt.Errorf("game is finished")
+	ErrNotAPlayer    = fmt.Errorf("player is not taking part in the game")
+	ErrNotInvited    = fmt.Errorf("player has not been invited to the game")
+	ErrJoined        = fmt.Errorf("player has already joined the game")
+	ErrNotYourTurn   = fmt.Errorf("it is not the player's turn")
+	ErrInvalidLayout = fmt.Errorf("invalid mine layout")
+
+	ErrMatchNotFound   = fmt.Errorf("match not found")
+	ErrTooFewPlayers   = fmt.Errorf("match needs at least 2 players")
+	ErrDuplicatePlayer = fmt.Errorf("player appears more than once")
+	ErrInMatch         = fmt.Errorf("games in a match can't be shared")
 )
@@ -1 +1,499 @@
 package sweeper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+)
+
+func TestTopology_Neighbours(t *testing.T) {
+	t.Parallel()
+
+	for topology, want := range map[sweeper.Topology]int{
+		sweeper.TopologySquare:   8,
+		sweeper.TopologyHex:      6,
+		sweeper.TopologyTriangle: 12,
+	} {
+		t.Run(topology.String(), func(t *testing.T) {
+			t.Parallel()
+
+			for row := range 2 {
+				for col := range 2 {
+					ref := sweeper.CellRef{Row: row, Column: col}
+
+					ns := topology.Neighbours(ref)
+					if len(ns) != want {
+						t.Errorf("%v: want %d neighbours, got %d", ref, want, len(ns))
+					}
+
+					// neighbouring must be symmetric, or mine counts won't add up
+					for _, n := range ns {
+						if !slices.Contains(topology.Neighbours(n), ref) {
+							t.Errorf("%v neighbours %v, but not the other way around", ref, n)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestBoard_Neighbours_wrap(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name  string
+		board sweeper.Board
+		ref   sweeper.CellRef
+		want  []sweeper.CellRef
+	}{
+		{
+			name:  "corner wraps to opposite corners",
+			board: sweeper.Board{Width: 4, Height: 4, Wrap: true},
+			ref:   sweeper.CellRef{Row: 0, Column: 0},
+			want: []sweeper.CellRef{
+				{Row: 3, Column: 3}, {Row: 3, Column: 0}, {Row: 3, Column: 1},
+				{Row: 0, Column: 3}, {Row: 0, Column: 1},
+				{Row: 1, Column: 3}, {Row: 1, Column: 0}, {Row: 1, Column: 1},
+			},
+		},
+		{
+			name:  "narrow board does not repeat neighbours",
+			board: sweeper.Board{Width: 2, Height: 1, Wrap: true},
+			ref:   sweeper.CellRef{Row: 0, Column: 0},
+			want:  []sweeper.CellRef{{Row: 0, Column: 1}},
+		},
+		{
+			name:  "unwrapped corner",
+			board: sweeper.Board{Width: 4, Height: 4},
+			ref:   sweeper.CellRef{Row: 0, Column: 0},
+			want: []sweeper.CellRef{
+				{Row: 0, Column: 1}, {Row: 1, Column: 0}, {Row: 1, Column: 1},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.board.Neighbours(tc.ref); !slices.Equal(got, tc.want) {
+				t.Errorf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNewGame_dense(t *testing.T) {
+	t.Parallel()
+
+	for _, board := range []sweeper.Board{
+		{Width: 10, Height: 10, Mines: 99},
+		{Width: 5, Height: 4, Depth: 2, Mines: 117, MaxMinesPerCell: 3},
+	} {
+		g, err := sweeper.NewGame(context.Background(), uuid.New, sweeper.SeededNumberGenerator(1), board)
+		if err != nil {
+			t.Fatalf("%+v: creating game: %v", board, err)
+		}
+
+		var mines int
+		g.Cells.Each(func(_ sweeper.CellRef, c sweeper.Cell) { mines += c.Mines })
+		if mines != board.Mines {
+			t.Errorf("%+v: want %d mines, got %d", board, board.Mines, mines)
+		}
+		// the only safe cell is the opening, which wins the game.
+		if g.Opening == nil || g.Cells.At(*g.Opening).ContainsMine() || g.State != sweeper.GameWon {
+			t.Errorf("%+v: want safe opening to win game, got opening %v and state %v", board, g.Opening, g.State)
+		}
+	}
+}
+
+func TestCells(t *testing.T) {
+	t.Parallel()
+
+	var (
+		cells = sweeper.NewCells(sweeper.Board{Width: 3, Height: 2, Depth: 2})
+		ref   = sweeper.CellRef{Layer: 1, Row: 1, Column: 2}
+		off   = sweeper.CellRef{Row: 2}
+	)
+	if cells.Len() != 12 {
+		t.Fatalf("want 12 cells, got %d", cells.Len())
+	}
+
+	cells.Set(ref, sweeper.Cell{Mines: 1})
+	cells.Set(off, sweeper.Cell{Mines: 1})
+	if i := cells.IndexOf(ref); i != 11 || cells.Ref(i) != ref || !cells.Nth(i).ContainsMine() {
+		t.Errorf("want %v to be the last cell with a mine, got index %d", ref, i)
+	}
+	if cells.IndexOf(off) != -1 || cells.At(off) != (sweeper.Cell{}) {
+		t.Errorf("want %v to be off the board and empty", off)
+	}
+
+	clone := cells.Clone()
+	clone.Set(ref, sweeper.Cell{})
+	if !cells.At(ref).ContainsMine() || cells.Equal(clone) {
+		t.Error("want changing a clone to leave the original alone")
+	}
+}
+
+func TestGame_multimines(t *testing.T) {
+	t.Parallel()
+
+	// mines: 2 in column 0, 1 in column 3. reveals column 5, which floods to 4.
+	g, err := sweeper.NewGame(
+		context.Background(),
+		uuid.New,
+		sequence(5, 0, 0, 3),
+		sweeper.Board{Width: 6, Height: 1, Mines: 3, MaxMinesPerCell: 2},
+	)
+	if err != nil {
+		t.Fatalf("creating game: %v", err)
+	}
+
+	if n := g.Cells.At(sweeper.CellRef{Column: 1}).NeighbouringMines; n != 2 {
+		t.Errorf("want column 1 to neighbour 2 mines, got %d", n)
+	}
+
+	for _, move := range []struct {
+		col       int
+		wantFlags int
+		wantState sweeper.GameState
+	}{
+		{col: 0, wantFlags: 1, wantState: sweeper.GameOngoing},
+		{col: 0, wantFlags: 2, wantState: sweeper.GameOngoing},
+		{col: 0, wantFlags: 2, wantState: sweeper.GameOngoing},
+		{col: 3, wantFlags: 1, wantState: sweeper.GameWon},
+	} {
+		ref := sweeper.CellRef{Column: move.col}
+		if err := g.UpdateCell(ref, sweeper.CellFlagged); err != nil {
+			t.Fatalf("flagging %v: %v", ref, err)
+		}
+		if f := g.Cells.At(ref).Flags; f != move.wantFlags {
+			t.Errorf("flagging %v: want %d flags, got %d", ref, move.wantFlags, f)
+		}
+		if g.State != move.wantState {
+			t.Errorf("flagging %v: want state %v, got %v", ref, move.wantState, g.State)
+		}
+	}
+}
+
+func TestBoard_Neighbours_depth(t *testing.T) {
+	t.Parallel()
+
+	board := sweeper.Board{Width: 3, Height: 3, Depth: 3}
+
+	for _, tc := range []struct {
+		ref  sweeper.CellRef
+		want int
+	}{
+		{ref: sweeper.CellRef{Layer: 1, Row: 1, Column: 1}, want: 26},
+		{ref: sweeper.CellRef{Layer: 0, Row: 1, Column: 1}, want: 17},
+		{ref: sweeper.CellRef{Layer: 0, Row: 0, Column: 0}, want: 7},
+	} {
+		if got := board.Neighbours(tc.ref); len(got) != tc.want {
+			t.Errorf("%v: want %d neighbours, got %d", tc.ref, tc.want, len(got))
+		}
+	}
+}
+
+func TestGame_Apply_flagsRules(t *testing.T) {
+	t.Parallel()
+
+	// mines in columns 0, 3 and 6, with column 1 already revealed.
+	g, err := sweeper.NewGame(
+		context.Background(),
+		uuid.New,
+		sequence(1, 0, 3, 1),
+		sweeper.Board{Width: 7, Height: 1, Mines: 3, Rules: sweeper.RulesFlags},
+	)
+	if err != nil {
+		t.Fatalf("creating game: %v", err)
+	}
+	g.Players = []sweeper.Player{{ID: "alice"}, {ID: "bob"}}
+
+	for _, move := range []struct {
+		player   sweeper.PlayerID
+		col      int
+		wantErr  error
+		wantTurn int
+	}{
+		{player: "bob", col: 2, wantErr: sweeper.ErrNotYourTurn, wantTurn: 0},
+		{player: "alice", col: 2, wantTurn: 1},
+		{player: "alice", col: 4, wantErr: sweeper.ErrNotYourTurn, wantTurn: 1},
+		{player: "bob", col: 0, wantTurn: 1},
+		{player: "bob", col: 3, wantTurn: 1},
+	} {
+		err := g.Apply(sweeper.Move{
+			Player: move.player,
+			Ref:    sweeper.CellRef{Column: move.col},
+			State:  sweeper.CellRevealed,
+		})
+		if !errors.Is(err, move.wantErr) {
+			t.Fatalf("%s revealing %d: want error %v, got %v", move.player, move.col, move.wantErr, err)
+		}
+		if g.Turn != move.wantTurn {
+			t.Errorf("%s revealing %d: want turn %d, got %d", move.player, move.col, move.wantTurn, g.Turn)
+		}
+	}
+
+	if g.State != sweeper.GameWon || g.Winner != "bob" {
+		t.Errorf("want bob to have won, got state %v and winner %q", g.State, g.Winner)
+	}
+	if s := g.Players[1].Score; s != 2 {
+		t.Errorf("want bob to have claimed 2 mines, got %d", s)
+	}
+}
+
+func TestGame_Apply_flagsEndings(t *testing.T) {
+	t.Parallel()
+
+	// mines in columns 0 and 3, with nothing revealed.
+	newGame := func(t *testing.T, players ...sweeper.PlayerID) *sweeper.Game {
+		t.Helper()
+		g, err := sweeper.NewGameFromLayout(
+			uuid.New,
+			sweeper.Board{Width: 4, Height: 1, Mines: 2, Rules: sweeper.RulesFlags},
+			sweeper.Layout{Mines: []sweeper.CellRef{{Column: 0}, {Column: 3}}},
+		)
+		if err != nil {
+			t.Fatalf("creating game: %v", err)
+		}
+		for _, p := range players {
+			g.Players = append(g.Players, sweeper.Player{ID: p})
+		}
+		return g
+	}
+	reveal := func(t *testing.T, g *sweeper.Game, player sweeper.PlayerID, col int) error {
+		t.Helper()
+		return g.Apply(sweeper.Move{Player: player, Ref: sweeper.CellRef{Column: col}, State: sweeper.CellRevealed})
+	}
+
+	t.Run("claimed mines can't be claimed again", func(t *testing.T) {
+		t.Parallel()
+
+		g := newGame(t, "alice", "bob")
+		if err := reveal(t, g, "alice", 0); err != nil {
+			t.Fatalf("claiming mine: %v", err)
+		}
+		if err := reveal(t, g, "alice", 0); !errors.Is(err, sweeper.ErrRevealed) {
+			t.Errorf("claiming mine again: want %v, got %v", sweeper.ErrRevealed, err)
+		}
+		if g.State != sweeper.GameOngoing || g.Players[0].Score != 1 || len(g.Moves) != 1 {
+			t.Errorf("want ongoing game with 1 mine claimed in 1 move, got %v with %d in %d", g.State, g.Players[0].Score, len(g.Moves))
+		}
+	})
+
+	t.Run("tied leaders draw", func(t *testing.T) {
+		t.Parallel()
+
+		g := newGame(t, "alice", "bob")
+		for _, m := range []struct {
+			player sweeper.PlayerID
+			col    int
+		}{{"alice", 1}, {"bob", 0}, {"bob", 2}, {"alice", 3}} {
+			if err := reveal(t, g, m.player, m.col); err != nil {
+				t.Fatalf("%s revealing %d: %v", m.player, m.col, err)
+			}
+		}
+		if g.State != sweeper.GameDrawn || g.Winner != "" {
+			t.Errorf("want draw, got state %v and winner %q", g.State, g.Winner)
+		}
+	})
+
+	t.Run("games without players end", func(t *testing.T) {
+		t.Parallel()
+
+		g := newGame(t)
+		for _, col := range []int{0, 3} {
+			if err := reveal(t, g, "", col); err != nil {
+				t.Fatalf("revealing %d: %v", col, err)
+			}
+		}
+		if g.State != sweeper.GameWon {
+			t.Errorf("want state %v, got %v", sweeper.GameWon, g.State)
+		}
+	})
+}
+
+func TestNewGameFromLayout(t *testing.T) {
+	t.Parallel()
+
+	board, layout, err := sweeper.ParseLayout("*..\n...\n..o\n")
+	if err != nil {
+		t.Fatalf("parsing layout: %v", err)
+	}
+	if board.Width != 3 || board.Height != 3 || board.Mines != 1 {
+		t.Fatalf("want 3x3 board with 1 mine, got %+v", board)
+	}
+
+	g, err := sweeper.NewGameFromLayout(uuid.New, board, layout)
+	if err != nil {
+		t.Fatalf("creating game: %v", err)
+	}
+	if !g.Cells.At(sweeper.CellRef{}).ContainsMine() {
+		t.Error("want mine in top left")
+	}
+	// revealing the bottom right floods the whole board, leaving only the mine.
+	if g.State != sweeper.GameWon {
+		t.Errorf("want state %v, got %v", sweeper.GameWon, g.State)
+	}
+
+	for name, tc := range map[string]struct {
+		layout  sweeper.Layout
+		wantErr error
+	}{
+		"mine out of bounds": {
+			layout:  sweeper.Layout{Mines: []sweeper.CellRef{{Row: 3}}},
+			wantErr: sweeper.ErrOutOfBounds,
+		},
+		"too many mines in cell": {
+			layout:  sweeper.Layout{Mines: []sweeper.CellRef{{}, {}}},
+			wantErr: sweeper.ErrOutOfBounds,
+		},
+		"reveal out of bounds": {
+			layout:  sweeper.Layout{Mines: []sweeper.CellRef{{}}, Reveal: &sweeper.CellRef{Column: -1}},
+			wantErr: sweeper.ErrOutOfBounds,
+		},
+		"reveal mine": {
+			layout:  sweeper.Layout{Mines: []sweeper.CellRef{{}}, Reveal: &sweeper.CellRef{}},
+			wantErr: sweeper.ErrInvalidLayout,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := sweeper.NewGameFromLayout(uuid.New, sweeper.Board{Width: 3, Height: 3}, tc.layout)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("want error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestParseLayout_runes(t *testing.T) {
+	t.Parallel()
+
+	// a multi-byte glyph is one cell wide, so the rows line up and the glyph is
+	// rejected rather than the row's width.
+	_, _, err := sweeper.ParseLayout("*.\n.é\n")
+	if !errors.Is(err, sweeper.ErrInvalidLayout) || !strings.Contains(err.Error(), "unknown cell") {
+		t.Errorf("want unknown cell error, got %v", err)
+	}
+}
+
+func TestGame_Chord(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now    = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		centre = sweeper.CellRef{Row: 1, Column: 1}
+	)
+
+	newGame := func(t *testing.T) *sweeper.Game {
+		board, layout, err := sweeper.ParseLayout("*....\n.....\n....*\n")
+		if err != nil {
+			t.Fatalf("parsing layout: %v", err)
+		}
+		g, err := sweeper.NewGameFromLayout(uuid.New, board, layout)
+		if err != nil {
+			t.Fatalf("creating game: %v", err)
+		}
+		if err := g.Apply(sweeper.Move{Ref: centre, State: sweeper.CellRevealed, At: now}); err != nil {
+			t.Fatalf("revealing centre: %v", err)
+		}
+		return g
+	}
+
+	t.Run("reveals unflagged neighbours", func(t *testing.T) {
+		t.Parallel()
+
+		g := newGame(t)
+
+		// without the mine flagged, chording does nothing.
+		if err := g.Chord("", centre, now); err != nil {
+			t.Fatalf("chording unflagged: %v", err)
+		}
+		if len(g.Moves) != 1 {
+			t.Fatalf("want chord without flags to do nothing, got %d moves", len(g.Moves))
+		}
+
+		if err := g.Apply(sweeper.Move{Ref: sweeper.CellRef{}, State: sweeper.CellFlagged, At: now}); err != nil {
+			t.Fatalf("flagging mine: %v", err)
+		}
+		if err := g.Chord("", centre, now); err != nil {
+			t.Fatalf("chording: %v", err)
+		}
+		for _, ref := range g.Board.Neighbours(centre) {
+			if c := g.Cells.At(ref); ref != (sweeper.CellRef{}) && c.State != sweeper.CellRevealed {
+				t.Errorf("want %v revealed, got %v", ref, c.State)
+			}
+		}
+	})
+
+	t.Run("misplaced flag loses", func(t *testing.T) {
+		t.Parallel()
+
+		g := newGame(t)
+		if err := g.Apply(sweeper.Move{Ref: sweeper.CellRef{Column: 1}, State: sweeper.CellFlagged, At: now}); err != nil {
+			t.Fatalf("flagging safe cell: %v", err)
+		}
+		if err := g.Chord("", centre, now); err != nil {
+			t.Fatalf("chording: %v", err)
+		}
+		if g.State != sweeper.GameLost {
+			t.Errorf("want state %v, got %v", sweeper.GameLost, g.State)
+		}
+		if err := g.Chord("", centre, now); !errors.Is(err, sweeper.ErrGameFinished) {
+			t.Errorf("chording finished game: want %v, got %v", sweeper.ErrGameFinished, err)
+		}
+	})
+}
+
+func BenchmarkNewGame(b *testing.B) {
+	for _, size := range []int{100, 1000} {
+		for _, density := range []int{10, 50, 90, 99} {
+			board := sweeper.Board{Width: size, Height: size, Mines: size * size * density / 100}
+			b.Run(fmt.Sprintf("%dx%d/%d%%", size, size, density), func(b *testing.B) {
+				numberGen := sweeper.SeededNumberGenerator(1)
+				for range b.N {
+					if _, err := sweeper.NewGame(context.Background(), uuid.New, numberGen, board); err != nil {
+						b.Fatalf("creating game: %v", err)
+					}
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkGame_UpdateCell_flood(b *testing.B) {
+	// a single mine in the corner, so revealing the opposite corner floods
+	// every other cell.
+	g, err := sweeper.NewGameFromLayout(
+		uuid.New,
+		sweeper.Board{Width: 1000, Height: 1000},
+		sweeper.Layout{Mines: []sweeper.CellRef{{}}},
+	)
+	if err != nil {
+		b.Fatalf("creating game: %v", err)
+	}
+	ref := sweeper.CellRef{Row: 999, Column: 999}
+
+	for range b.N {
+		b.StopTimer()
+		c := g.Clone()
+		b.StartTimer()
+
+		if err := c.UpdateCell(ref, sweeper.CellRevealed); err != nil {
+			b.Fatalf("revealing: %v", err)
+		}
+		if c.State != sweeper.GameWon {
+			b.Fatalf("want flood to win game, got %v", c.State)
+		}
+	}
+}
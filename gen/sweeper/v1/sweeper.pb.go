@@ -9,7 +9,9 @@ package sweeperv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -73,6 +75,107 @@ func (UnrevealedCellMarking) EnumDescriptor() ([]byte, []int) {
 	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{0}
 }
 
+type Topology int32
+
+const (
+	Topology_TOPOLOGY_UNKNOWN Topology = 0
+	Topology_SQUARE           Topology = 1 // Cells are squares, with 8 neighbours.
+	Topology_HEX              Topology = 2 // Cells are hexagons, with 6 neighbours. Odd rows are offset half a cell to the right.
+	Topology_TRIANGLE         Topology = 3 // Cells are triangles, with 12 neighbours. The cell at (0, 0) points up, and orientation alternates along each row and column.
+)
+
+// Enum value maps for Topology.
+var (
+	Topology_name = map[int32]string{
+		0: "TOPOLOGY_UNKNOWN",
+		1: "SQUARE",
+		2: "HEX",
+		3: "TRIANGLE",
+	}
+	Topology_value = map[string]int32{
+		"TOPOLOGY_UNKNOWN": 0,
+		"SQUARE":           1,
+		"HEX":              2,
+		"TRIANGLE":         3,
+	}
+)
+
+func (x Topology) Enum() *Topology {
+	p := new(Topology)
+	*p = x
+	return p
+}
+
+func (x Topology) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Topology) Descriptor() protoreflect.EnumDescriptor {
+	return file_sweeper_v1_sweeper_proto_enumTypes[1].Descriptor()
+}
+
+func (Topology) Type() protoreflect.EnumType {
+	return &file_sweeper_v1_sweeper_proto_enumTypes[1]
+}
+
+func (x Topology) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Topology.Descriptor instead.
+func (Topology) EnumDescriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{1}
+}
+
+type Rules int32
+
+const (
+	Rules_RULES_UNKNOWN Rules = 0
+	Rules_CLASSIC       Rules = 1 // Revealing a mine loses the game. It is won by revealing every safe cell, or flagging every mine.
+	Rules_MINE_FLAGS    Rules = 2 // Players take turns to reveal cells. Revealing a mine claims it and keeps the turn. The first player to claim a majority of the mines wins.
+)
+
+// Enum value maps for Rules.
+var (
+	Rules_name = map[int32]string{
+		0: "RULES_UNKNOWN",
+		1: "CLASSIC",
+		2: "MINE_FLAGS",
+	}
+	Rules_value = map[string]int32{
+		"RULES_UNKNOWN": 0,
+		"CLASSIC":       1,
+		"MINE_FLAGS":    2,
+	}
+)
+
+func (x Rules) Enum() *Rules {
+	p := new(Rules)
+	*p = x
+	return p
+}
+
+func (x Rules) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rules) Descriptor() protoreflect.EnumDescriptor {
+	return file_sweeper_v1_sweeper_proto_enumTypes[2].Descriptor()
+}
+
+func (Rules) Type() protoreflect.EnumType {
+	return &file_sweeper_v1_sweeper_proto_enumTypes[2]
+}
+
+func (x Rules) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rules.Descriptor instead.
+func (Rules) EnumDescriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{2}
+}
+
 type GameState int32
 
 const (
@@ -81,6 +184,8 @@ const (
 	GameState_WON                GameState = 2
 	GameState_LOST               GameState = 3
 	GameState_RESIGNED           GameState = 4
+	GameState_TIMED_OUT          GameState = 5
+	GameState_DRAWN              GameState = 6 // Only used by MINE_FLAGS games, when the leading players tie.
 )
 
 // Enum value maps for GameState.
@@ -91,6 +196,8 @@ var (
 		2: "WON",
 		3: "LOST",
 		4: "RESIGNED",
+		5: "TIMED_OUT",
+		6: "DRAWN",
 	}
 	GameState_value = map[string]int32{
 		"GAME_STATE_UNKNOWN": 0,
@@ -98,6 +205,8 @@ var (
 		"WON":                2,
 		"LOST":               3,
 		"RESIGNED":           4,
+		"TIMED_OUT":          5,
+		"DRAWN":              6,
 	}
 )
 
@@ -112,11 +221,11 @@ func (x GameState) String() string {
 }
 
 func (GameState) Descriptor() protoreflect.EnumDescriptor {
-	return file_sweeper_v1_sweeper_proto_enumTypes[1].Descriptor()
+	return file_sweeper_v1_sweeper_proto_enumTypes[3].Descriptor()
 }
 
 func (GameState) Type() protoreflect.EnumType {
-	return &file_sweeper_v1_sweeper_proto_enumTypes[1]
+	return &file_sweeper_v1_sweeper_proto_enumTypes[3]
 }
 
 func (x GameState) Number() protoreflect.EnumNumber {
@@ -125,7 +234,7 @@ func (x GameState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GameState.Descriptor instead.
 func (GameState) EnumDescriptor() ([]byte, []int) {
-	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{1}
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{3}
 }
 
 type CellMoveAction int32
@@ -136,6 +245,7 @@ const (
 	CellMoveAction_FLAG                     CellMoveAction = 2
 	CellMoveAction_QUESTION                 CellMoveAction = 3
 	CellMoveAction_REVEAL                   CellMoveAction = 4
+	CellMoveAction_CHORD                    CellMoveAction = 5 // Reveals every unflagged neighbour of a revealed cell, once all of its mines have been flagged.
 )
 
 // Enum value maps for CellMoveAction.
@@ -146,6 +256,7 @@ var (
 		2: "FLAG",
 		3: "QUESTION",
 		4: "REVEAL",
+		5: "CHORD",
 	}
 	CellMoveAction_value = map[string]int32{
 		"CELL_MOVE_ACTION_UNKNOWN": 0,
@@ -153,6 +264,7 @@ var (
 		"FLAG":                     2,
 		"QUESTION":                 3,
 		"REVEAL":                   4,
+		"CHORD":                    5,
 	}
 )
 
@@ -167,11 +279,11 @@ func (x CellMoveAction) String() string {
 }
 
 func (CellMoveAction) Descriptor() protoreflect.EnumDescriptor {
-	return file_sweeper_v1_sweeper_proto_enumTypes[2].Descriptor()
+	return file_sweeper_v1_sweeper_proto_enumTypes[4].Descriptor()
 }
 
 func (CellMoveAction) Type() protoreflect.EnumType {
-	return &file_sweeper_v1_sweeper_proto_enumTypes[2]
+	return &file_sweeper_v1_sweeper_proto_enumTypes[4]
 }
 
 func (x CellMoveAction) Number() protoreflect.EnumNumber {
@@ -180,7 +292,105 @@ func (x CellMoveAction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CellMoveAction.Descriptor instead.
 func (CellMoveAction) EnumDescriptor() ([]byte, []int) {
-	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{2}
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{4}
+}
+
+type LobbyMode int32
+
+const (
+	LobbyMode_LOBBY_MODE_UNKNOWN LobbyMode = 0
+	LobbyMode_VERSUS             LobbyMode = 1 // Each player gets their own game, grouped into a match.
+	LobbyMode_COOP               LobbyMode = 2 // Every player shares a single game.
+)
+
+// Enum value maps for LobbyMode.
+var (
+	LobbyMode_name = map[int32]string{
+		0: "LOBBY_MODE_UNKNOWN",
+		1: "VERSUS",
+		2: "COOP",
+	}
+	LobbyMode_value = map[string]int32{
+		"LOBBY_MODE_UNKNOWN": 0,
+		"VERSUS":             1,
+		"COOP":               2,
+	}
+)
+
+func (x LobbyMode) Enum() *LobbyMode {
+	p := new(LobbyMode)
+	*p = x
+	return p
+}
+
+func (x LobbyMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LobbyMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_sweeper_v1_sweeper_proto_enumTypes[5].Descriptor()
+}
+
+func (LobbyMode) Type() protoreflect.EnumType {
+	return &file_sweeper_v1_sweeper_proto_enumTypes[5]
+}
+
+func (x LobbyMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LobbyMode.Descriptor instead.
+func (LobbyMode) EnumDescriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{5}
+}
+
+type TournamentFormat int32
+
+const (
+	TournamentFormat_TOURNAMENT_FORMAT_UNKNOWN TournamentFormat = 0
+	TournamentFormat_BEST_OF                   TournamentFormat = 1 // Pairs play heats until one of them has won a majority of best_of heats.
+	TournamentFormat_FASTEST_TIME              TournamentFormat = 2 // Pairs play one heat, won by whoever clears their board fastest.
+)
+
+// Enum value maps for TournamentFormat.
+var (
+	TournamentFormat_name = map[int32]string{
+		0: "TOURNAMENT_FORMAT_UNKNOWN",
+		1: "BEST_OF",
+		2: "FASTEST_TIME",
+	}
+	TournamentFormat_value = map[string]int32{
+		"TOURNAMENT_FORMAT_UNKNOWN": 0,
+		"BEST_OF":                   1,
+		"FASTEST_TIME":              2,
+	}
+)
+
+func (x TournamentFormat) Enum() *TournamentFormat {
+	p := new(TournamentFormat)
+	*p = x
+	return p
+}
+
+func (x TournamentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_sweeper_v1_sweeper_proto_enumTypes[6].Descriptor()
+}
+
+func (TournamentFormat) Type() protoreflect.EnumType {
+	return &file_sweeper_v1_sweeper_proto_enumTypes[6]
+}
+
+func (x TournamentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentFormat.Descriptor instead.
+func (TournamentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{6}
 }
 
 type ClearRevealedCell struct {
@@ -188,7 +398,7 @@ type ClearRevealedCell struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NeighbouringMines int32 `protobuf:"varint,1,opt,name=neighbouring_mines,json=neighbouringMines,proto3" json:"neighbouring_mines,omitempty"`
+	NeighbouringMines int32 `protobuf:"varint,1,opt,name=neighbouring_mines,json=neighbouringMines,proto3" json:"neighbouring_mines,omitempty"` // The total number of mines in the cell's neighbours.
 }
 
 func (x *ClearRevealedCell) Reset() {
@@ -325,6 +535,8 @@ type Cell struct {
 	//	*Cell_Questioned
 	//	*Cell_Revealed
 	State isCell_State `protobuf_oneof:"state"`
+	Flags int32        `protobuf:"varint,7,opt,name=flags,proto3" json:"flags,omitempty"` // How many flags have been placed on a flagged cell.
+	Layer int32        `protobuf:"varint,8,opt,name=layer,proto3" json:"layer,omitempty"` // Always 0 unless the board has a depth.
 }
 
 func (x *Cell) Reset() {
@@ -408,6 +620,20 @@ func (x *Cell) GetRevealed() *RevealedCell {
 	return nil
 }
 
+func (x *Cell) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *Cell) GetLayer() int32 {
+	if x != nil {
+		return x.Layer
+	}
+	return 0
+}
+
 type isCell_State interface {
 	isCell_State()
 }
@@ -441,9 +667,15 @@ type Board struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Height int32 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	Width  int32 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
-	Mines  int32 `protobuf:"varint,3,opt,name=mines,proto3" json:"mines,omitempty"`
+	Height          int32                `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Width           int32                `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Mines           int32                `protobuf:"varint,3,opt,name=mines,proto3" json:"mines,omitempty"`
+	TimeLimit       *durationpb.Duration `protobuf:"bytes,4,opt,name=time_limit,json=timeLimit,proto3" json:"time_limit,omitempty"`                        // How long the player has to finish the game. Unset if untimed.
+	Topology        Topology             `protobuf:"varint,5,opt,name=topology,proto3,enum=sweeper.v1.Topology" json:"topology,omitempty"`                 // Defaults to SQUARE if unknown.
+	Wrap            bool                 `protobuf:"varint,6,opt,name=wrap,proto3" json:"wrap,omitempty"`                                                  // If set, opposite edges of the board are joined - cells on one edge neighbour the cells on the other.
+	MaxMinesPerCell int32                `protobuf:"varint,7,opt,name=max_mines_per_cell,json=maxMinesPerCell,proto3" json:"max_mines_per_cell,omitempty"` // How many mines a single cell may hold. Defaults to 1 if unset.
+	Depth           int32                `protobuf:"varint,8,opt,name=depth,proto3" json:"depth,omitempty"`                                                // How many layers the board has. Cells neighbour the cells in the layers directly above and below them. Defaults to 1 if unset.
+	Rules           Rules                `protobuf:"varint,9,opt,name=rules,proto3,enum=sweeper.v1.Rules" json:"rules,omitempty"`                          // Defaults to CLASSIC if unknown.
 }
 
 func (x *Board) Reset() {
@@ -499,104 +731,75 @@ func (x *Board) GetMines() int32 {
 	return 0
 }
 
-type Game struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id    string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	State GameState `protobuf:"varint,2,opt,name=state,proto3,enum=sweeper.v1.GameState" json:"state,omitempty"`
-	Board *Board    `protobuf:"bytes,3,opt,name=board,proto3" json:"board,omitempty"`
-	Cells []*Cell   `protobuf:"bytes,4,rep,name=cells,proto3" json:"cells,omitempty"`
-}
-
-func (x *Game) Reset() {
-	*x = Game{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sweeper_v1_sweeper_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Board) GetTimeLimit() *durationpb.Duration {
+	if x != nil {
+		return x.TimeLimit
 	}
+	return nil
 }
 
-func (x *Game) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Game) ProtoMessage() {}
-
-func (x *Game) ProtoReflect() protoreflect.Message {
-	mi := &file_sweeper_v1_sweeper_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Board) GetTopology() Topology {
+	if x != nil {
+		return x.Topology
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Game.ProtoReflect.Descriptor instead.
-func (*Game) Descriptor() ([]byte, []int) {
-	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{4}
+	return Topology_TOPOLOGY_UNKNOWN
 }
 
-func (x *Game) GetId() string {
+func (x *Board) GetWrap() bool {
 	if x != nil {
-		return x.Id
+		return x.Wrap
 	}
-	return ""
+	return false
 }
 
-func (x *Game) GetState() GameState {
+func (x *Board) GetMaxMinesPerCell() int32 {
 	if x != nil {
-		return x.State
+		return x.MaxMinesPerCell
 	}
-	return GameState_GAME_STATE_UNKNOWN
+	return 0
 }
 
-func (x *Game) GetBoard() *Board {
+func (x *Board) GetDepth() int32 {
 	if x != nil {
-		return x.Board
+		return x.Depth
 	}
-	return nil
+	return 0
 }
 
-func (x *Game) GetCells() []*Cell {
+func (x *Board) GetRules() Rules {
 	if x != nil {
-		return x.Cells
+		return x.Rules
 	}
-	return nil
+	return Rules_RULES_UNKNOWN
 }
 
-type CellMove struct {
+type Player struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Row    int32          `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
-	Column int32          `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
-	Action CellMoveAction `protobuf:"varint,3,opt,name=action,proto3,enum=sweeper.v1.CellMoveAction" json:"action,omitempty"`
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CellsOpened int32  `protobuf:"varint,2,opt,name=cells_opened,json=cellsOpened,proto3" json:"cells_opened,omitempty"` // How many safe cells the player has revealed.
+	Score       int32  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`                                // How many mines the player has claimed. Only used by MINE_FLAGS games.
 }
 
-func (x *CellMove) Reset() {
-	*x = CellMove{}
+func (x *Player) Reset() {
+	*x = Player{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sweeper_v1_sweeper_proto_msgTypes[5]
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CellMove) String() string {
+func (x *Player) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CellMove) ProtoMessage() {}
+func (*Player) ProtoMessage() {}
 
-func (x *CellMove) ProtoReflect() protoreflect.Message {
-	mi := &file_sweeper_v1_sweeper_proto_msgTypes[5]
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,62 +810,69 @@ func (x *CellMove) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CellMove.ProtoReflect.Descriptor instead.
-func (*CellMove) Descriptor() ([]byte, []int) {
-	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CellMove) GetRow() int32 {
+func (x *Player) GetId() string {
 	if x != nil {
-		return x.Row
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *CellMove) GetColumn() int32 {
+func (x *Player) GetCellsOpened() int32 {
 	if x != nil {
-		return x.Column
+		return x.CellsOpened
 	}
 	return 0
 }
 
-func (x *CellMove) GetAction() CellMoveAction {
+func (x *Player) GetScore() int32 {
 	if x != nil {
-		return x.Action
+		return x.Score
 	}
-	return CellMoveAction_CELL_MOVE_ACTION_UNKNOWN
+	return 0
 }
 
-type MakeMoveRequest struct {
+type Game struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// Types that are assignable to Move:
-	//
-	//	*MakeMoveRequest_End
-	//	*MakeMoveRequest_Cell
-	Move isMakeMoveRequest_Move `protobuf_oneof:"move"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State            GameState              `protobuf:"varint,2,opt,name=state,proto3,enum=sweeper.v1.GameState" json:"state,omitempty"`
+	Board            *Board                 `protobuf:"bytes,3,opt,name=board,proto3" json:"board,omitempty"`
+	Cells            []*Cell                `protobuf:"bytes,4,rep,name=cells,proto3" json:"cells,omitempty"`
+	TimeRemaining    *durationpb.Duration   `protobuf:"bytes,5,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"`            // How long the player has left to finish the game. Unset if untimed.
+	Players          []*Player              `protobuf:"bytes,6,rep,name=players,proto3" json:"players,omitempty"`                                             // The players taking part in the game, in the order they joined. If empty, anyone may make moves.
+	InvitedPlayerIds []string               `protobuf:"bytes,7,rep,name=invited_player_ids,json=invitedPlayerIds,proto3" json:"invited_player_ids,omitempty"` // The players invited to the game that have not joined yet.
+	MatchId          string                 `protobuf:"bytes,8,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`                              // The match the game is being played in, if any.
+	TurnPlayerId     string                 `protobuf:"bytes,9,opt,name=turn_player_id,json=turnPlayerId,proto3" json:"turn_player_id,omitempty"`             // The player who must make the next move. Only set for ongoing MINE_FLAGS games.
+	WinnerId         string                 `protobuf:"bytes,10,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`                          // The player who won a MINE_FLAGS game. Unset if ongoing or drawn.
+	StartedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	Region           *Region                `protobuf:"bytes,12,opt,name=region,proto3" json:"region,omitempty"`   // The region of the board cells holds, if only part of it was asked for. Unset if it holds every cell.
+	Summary          *GameSummary           `protobuf:"bytes,13,opt,name=summary,proto3" json:"summary,omitempty"` // Counted over the whole board, even when cells only holds a region of it.
 }
 
-func (x *MakeMoveRequest) Reset() {
-	*x = MakeMoveRequest{}
+func (x *Game) Reset() {
+	*x = Game{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sweeper_v1_sweeper_proto_msgTypes[6]
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MakeMoveRequest) String() string {
+func (x *Game) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakeMoveRequest) ProtoMessage() {}
+func (*Game) ProtoMessage() {}
 
-func (x *MakeMoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sweeper_v1_sweeper_proto_msgTypes[6]
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,65 +883,178 @@ func (x *MakeMoveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakeMoveRequest.ProtoReflect.Descriptor instead.
-func (*MakeMoveRequest) Descriptor() ([]byte, []int) {
-	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *MakeMoveRequest) GetGameId() string {
+func (x *Game) GetId() string {
 	if x != nil {
-		return x.GameId
+		return x.Id
 	}
 	return ""
 }
 
-func (m *MakeMoveRequest) GetMove() isMakeMoveRequest_Move {
-	if m != nil {
-		return m.Move
+func (x *Game) GetState() GameState {
+	if x != nil {
+		return x.State
 	}
-	return nil
+	return GameState_GAME_STATE_UNKNOWN
 }
 
-func (x *MakeMoveRequest) GetEnd() *emptypb.Empty {
-	if x, ok := x.GetMove().(*MakeMoveRequest_End); ok {
-		return x.End
+func (x *Game) GetBoard() *Board {
+	if x != nil {
+		return x.Board
 	}
 	return nil
 }
 
-func (x *MakeMoveRequest) GetCell() *CellMove {
-	if x, ok := x.GetMove().(*MakeMoveRequest_Cell); ok {
-		return x.Cell
+func (x *Game) GetCells() []*Cell {
+	if x != nil {
+		return x.Cells
 	}
 	return nil
 }
 
-type isMakeMoveRequest_Move interface {
-	isMakeMoveRequest_Move()
+func (x *Game) GetTimeRemaining() *durationpb.Duration {
+	if x != nil {
+		return x.TimeRemaining
+	}
+	return nil
 }
 
-type MakeMoveRequest_End struct {
-	End *emptypb.Empty `protobuf:"bytes,2,opt,name=end,proto3,oneof"`
+func (x *Game) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
 }
 
-type MakeMoveRequest_Cell struct {
-	Cell *CellMove `protobuf:"bytes,3,opt,name=cell,proto3,oneof"`
+func (x *Game) GetInvitedPlayerIds() []string {
+	if x != nil {
+		return x.InvitedPlayerIds
+	}
+	return nil
 }
 
-func (*MakeMoveRequest_End) isMakeMoveRequest_Move() {}
+func (x *Game) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
 
-func (*MakeMoveRequest_Cell) isMakeMoveRequest_Move() {}
+func (x *Game) GetTurnPlayerId() string {
+	if x != nil {
+		return x.TurnPlayerId
+	}
+	return ""
+}
 
-type MakeMoveResponse struct {
+func (x *Game) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *Game) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Game) GetRegion() *Region {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+func (x *Game) GetSummary() *GameSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type GameSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Game *Game `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	FlagsPlaced    int32 `protobuf:"varint,1,opt,name=flags_placed,json=flagsPlaced,proto3" json:"flags_placed,omitempty"`
+	CellsRevealed  int32 `protobuf:"varint,2,opt,name=cells_revealed,json=cellsRevealed,proto3" json:"cells_revealed,omitempty"`
+	MinesRemaining int32 `protobuf:"varint,3,opt,name=mines_remaining,json=minesRemaining,proto3" json:"mines_remaining,omitempty"` // The board's mines less the flags placed. Negative if more flags have been placed than there are mines.
 }
 
-func (x *MakeMoveResponse) Reset() {
-	*x = MakeMoveResponse{}
+func (x *GameSummary) Reset() {
+	*x = GameSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSummary) ProtoMessage() {}
+
+func (x *GameSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSummary.ProtoReflect.Descriptor instead.
+func (*GameSummary) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GameSummary) GetFlagsPlaced() int32 {
+	if x != nil {
+		return x.FlagsPlaced
+	}
+	return 0
+}
+
+func (x *GameSummary) GetCellsRevealed() int32 {
+	if x != nil {
+		return x.CellsRevealed
+	}
+	return 0
+}
+
+func (x *GameSummary) GetMinesRemaining() int32 {
+	if x != nil {
+		return x.MinesRemaining
+	}
+	return 0
+}
+
+type CellMove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row    int32          `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Column int32          `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+	Action CellMoveAction `protobuf:"varint,3,opt,name=action,proto3,enum=sweeper.v1.CellMoveAction" json:"action,omitempty"`
+	Layer  int32          `protobuf:"varint,4,opt,name=layer,proto3" json:"layer,omitempty"` // Always 0 unless the board has a depth.
+}
+
+func (x *CellMove) Reset() {
+	*x = CellMove{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sweeper_v1_sweeper_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -739,13 +1062,13 @@ func (x *MakeMoveResponse) Reset() {
 	}
 }
 
-func (x *MakeMoveResponse) String() string {
+func (x *CellMove) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakeMoveResponse) ProtoMessage() {}
+func (*CellMove) ProtoMessage() {}
 
-func (x *MakeMoveResponse) ProtoReflect() protoreflect.Message {
+func (x *CellMove) ProtoReflect() protoreflect.Message {
 	mi := &file_sweeper_v1_sweeper_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -757,28 +1080,56 @@ func (x *MakeMoveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakeMoveResponse.ProtoReflect.Descriptor instead.
-func (*MakeMoveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CellMove.ProtoReflect.Descriptor instead.
+func (*CellMove) Descriptor() ([]byte, []int) {
 	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *MakeMoveResponse) GetGame() *Game {
+func (x *CellMove) GetRow() int32 {
 	if x != nil {
-		return x.Game
+		return x.Row
 	}
-	return nil
+	return 0
 }
 
-type StartGameRequest struct {
+func (x *CellMove) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *CellMove) GetAction() CellMoveAction {
+	if x != nil {
+		return x.Action
+	}
+	return CellMoveAction_CELL_MOVE_ACTION_UNKNOWN
+}
+
+func (x *CellMove) GetLayer() int32 {
+	if x != nil {
+		return x.Layer
+	}
+	return 0
+}
+
+type MakeMoveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Board *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	GameId   string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId string `protobuf:"bytes,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Types that are assignable to Move:
+	//
+	//	*MakeMoveRequest_End
+	//	*MakeMoveRequest_Cell
+	Move   isMakeMoveRequest_Move `protobuf_oneof:"move"`
+	Region *Region                `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"` // The region of the board to return, in every layer. The whole board is returned if unset.
 }
 
-func (x *StartGameRequest) Reset() {
-	*x = StartGameRequest{}
+func (x *MakeMoveRequest) Reset() {
+	*x = MakeMoveRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sweeper_v1_sweeper_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -786,13 +1137,13 @@ func (x *StartGameRequest) Reset() {
 	}
 }
 
-func (x *StartGameRequest) String() string {
+func (x *MakeMoveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartGameRequest) ProtoMessage() {}
+func (*MakeMoveRequest) ProtoMessage() {}
 
-func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
+func (x *MakeMoveRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sweeper_v1_sweeper_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -804,19 +1155,70 @@ func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
-func (*StartGameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MakeMoveRequest.ProtoReflect.Descriptor instead.
+func (*MakeMoveRequest) Descriptor() ([]byte, []int) {
 	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *StartGameRequest) GetBoard() *Board {
+func (x *MakeMoveRequest) GetGameId() string {
 	if x != nil {
-		return x.Board
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *MakeMoveRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (m *MakeMoveRequest) GetMove() isMakeMoveRequest_Move {
+	if m != nil {
+		return m.Move
 	}
 	return nil
 }
 
-type StartGameResponse struct {
+func (x *MakeMoveRequest) GetEnd() *emptypb.Empty {
+	if x, ok := x.GetMove().(*MakeMoveRequest_End); ok {
+		return x.End
+	}
+	return nil
+}
+
+func (x *MakeMoveRequest) GetCell() *CellMove {
+	if x, ok := x.GetMove().(*MakeMoveRequest_Cell); ok {
+		return x.Cell
+	}
+	return nil
+}
+
+func (x *MakeMoveRequest) GetRegion() *Region {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+type isMakeMoveRequest_Move interface {
+	isMakeMoveRequest_Move()
+}
+
+type MakeMoveRequest_End struct {
+	End *emptypb.Empty `protobuf:"bytes,2,opt,name=end,proto3,oneof"`
+}
+
+type MakeMoveRequest_Cell struct {
+	Cell *CellMove `protobuf:"bytes,3,opt,name=cell,proto3,oneof"`
+}
+
+func (*MakeMoveRequest_End) isMakeMoveRequest_Move() {}
+
+func (*MakeMoveRequest_Cell) isMakeMoveRequest_Move() {}
+
+type MakeMoveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -824,8 +1226,8 @@ type StartGameResponse struct {
 	Game *Game `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
 }
 
-func (x *StartGameResponse) Reset() {
-	*x = StartGameResponse{}
+func (x *MakeMoveResponse) Reset() {
+	*x = MakeMoveResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sweeper_v1_sweeper_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -833,13 +1235,13 @@ func (x *StartGameResponse) Reset() {
 	}
 }
 
-func (x *StartGameResponse) String() string {
+func (x *MakeMoveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartGameResponse) ProtoMessage() {}
+func (*MakeMoveResponse) ProtoMessage() {}
 
-func (x *StartGameResponse) ProtoReflect() protoreflect.Message {
+func (x *MakeMoveResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sweeper_v1_sweeper_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -851,28 +1253,30 @@ func (x *StartGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartGameResponse.ProtoReflect.Descriptor instead.
-func (*StartGameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MakeMoveResponse.ProtoReflect.Descriptor instead.
+func (*MakeMoveResponse) Descriptor() ([]byte, []int) {
 	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *StartGameResponse) GetGame() *Game {
+func (x *MakeMoveResponse) GetGame() *Game {
 	if x != nil {
 		return x.Game
 	}
 	return nil
 }
 
-type GetGameRequest struct {
+type MakeMovesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	GameId   string      `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId string      `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Moves    []*CellMove `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"` // Applied in order, and atomically: if any move fails, none of them are applied.
 }
 
-func (x *GetGameRequest) Reset() {
-	*x = GetGameRequest{}
+func (x *MakeMovesRequest) Reset() {
+	*x = MakeMovesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sweeper_v1_sweeper_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -880,13 +1284,13 @@ func (x *GetGameRequest) Reset() {
 	}
 }
 
-func (x *GetGameRequest) String() string {
+func (x *MakeMovesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameRequest) ProtoMessage() {}
+func (*MakeMovesRequest) ProtoMessage() {}
 
-func (x *GetGameRequest) ProtoReflect() protoreflect.Message {
+func (x *MakeMovesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sweeper_v1_sweeper_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -898,28 +1302,43 @@ func (x *GetGameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameRequest.ProtoReflect.Descriptor instead.
-func (*GetGameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MakeMovesRequest.ProtoReflect.Descriptor instead.
+func (*MakeMovesRequest) Descriptor() ([]byte, []int) {
 	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetGameRequest) GetGameId() string {
+func (x *MakeMovesRequest) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
 	return ""
 }
 
-type GetGameResponse struct {
+func (x *MakeMovesRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *MakeMovesRequest) GetMoves() []*CellMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+type MakeMovesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Game *Game `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	Game    *Game `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	Applied int32 `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"` // How many of the moves were applied. Fewer than were sent if one of them ended the game.
 }
 
-func (x *GetGameResponse) Reset() {
-	*x = GetGameResponse{}
+func (x *MakeMovesResponse) Reset() {
+	*x = MakeMovesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sweeper_v1_sweeper_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -927,13 +1346,13 @@ func (x *GetGameResponse) Reset() {
 	}
 }
 
-func (x *GetGameResponse) String() string {
+func (x *MakeMovesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameResponse) ProtoMessage() {}
+func (*MakeMovesResponse) ProtoMessage() {}
 
-func (x *GetGameResponse) ProtoReflect() protoreflect.Message {
+func (x *MakeMovesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sweeper_v1_sweeper_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -945,134 +1364,3378 @@ func (x *GetGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameResponse.ProtoReflect.Descriptor instead.
-func (*GetGameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MakeMovesResponse.ProtoReflect.Descriptor instead.
+func (*MakeMovesResponse) Descriptor() ([]byte, []int) {
 	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetGameResponse) GetGame() *Game {
+func (x *MakeMovesResponse) GetGame() *Game {
 	if x != nil {
 		return x.Game
 	}
 	return nil
 }
 
-var File_sweeper_v1_sweeper_proto protoreflect.FileDescriptor
-
+func (x *MakeMovesResponse) GetApplied() int32 {
+	if x != nil {
+		return x.Applied
+	}
+	return 0
+}
+
+// Attached as a detail to the error returned when one of the moves sent to MakeMoves fails. The error has the code of
+// the failing move.
+type MoveFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // The index of the move that failed.
+}
+
+func (x *MoveFailure) Reset() {
+	*x = MoveFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFailure) ProtoMessage() {}
+
+func (x *MoveFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFailure.ProtoReflect.Descriptor instead.
+func (*MoveFailure) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MoveFailure) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type StartGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Board    *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // If set, the player becomes the first player of the game, and only they and the players they invite may make moves.
+}
+
+func (x *StartGameRequest) Reset() {
+	*x = StartGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameRequest) ProtoMessage() {}
+
+func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
+func (*StartGameRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StartGameRequest) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *StartGameRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type StartGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game *Game `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+}
+
+func (x *StartGameResponse) Reset() {
+	*x = StartGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameResponse) ProtoMessage() {}
+
+func (x *StartGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameResponse.ProtoReflect.Descriptor instead.
+func (*StartGameResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StartGameResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type CellRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Layer  int32 `protobuf:"varint,1,opt,name=layer,proto3" json:"layer,omitempty"`
+	Row    int32 `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	Column int32 `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
+}
+
+func (x *CellRef) Reset() {
+	*x = CellRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CellRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellRef) ProtoMessage() {}
+
+func (x *CellRef) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellRef.ProtoReflect.Descriptor instead.
+func (*CellRef) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CellRef) GetLayer() int32 {
+	if x != nil {
+		return x.Layer
+	}
+	return 0
+}
+
+func (x *CellRef) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *CellRef) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+type MineLayout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mines []*CellRef `protobuf:"bytes,1,rep,name=mines,proto3" json:"mines,omitempty"` // A cell listed more than once holds more than one mine.
+}
+
+func (x *MineLayout) Reset() {
+	*x = MineLayout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MineLayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MineLayout) ProtoMessage() {}
+
+func (x *MineLayout) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MineLayout.ProtoReflect.Descriptor instead.
+func (*MineLayout) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MineLayout) GetMines() []*CellRef {
+	if x != nil {
+		return x.Mines
+	}
+	return nil
+}
+
+type StartGameFromLayoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Board    *Board `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"` // The board's mines are counted from the layout, as are its dimensions when given a grid.
+	// Types that are assignable to Layout:
+	//
+	//	*StartGameFromLayoutRequest_Mines
+	//	*StartGameFromLayoutRequest_Grid
+	Layout isStartGameFromLayoutRequest_Layout `protobuf_oneof:"layout"`
+	Reveal *CellRef                            `protobuf:"bytes,5,opt,name=reveal,proto3" json:"reveal,omitempty"` // The cell to reveal when the game starts, if any. Only used with mines.
+}
+
+func (x *StartGameFromLayoutRequest) Reset() {
+	*x = StartGameFromLayoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartGameFromLayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameFromLayoutRequest) ProtoMessage() {}
+
+func (x *StartGameFromLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameFromLayoutRequest.ProtoReflect.Descriptor instead.
+func (*StartGameFromLayoutRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StartGameFromLayoutRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *StartGameFromLayoutRequest) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (m *StartGameFromLayoutRequest) GetLayout() isStartGameFromLayoutRequest_Layout {
+	if m != nil {
+		return m.Layout
+	}
+	return nil
+}
+
+func (x *StartGameFromLayoutRequest) GetMines() *MineLayout {
+	if x, ok := x.GetLayout().(*StartGameFromLayoutRequest_Mines); ok {
+		return x.Mines
+	}
+	return nil
+}
+
+func (x *StartGameFromLayoutRequest) GetGrid() string {
+	if x, ok := x.GetLayout().(*StartGameFromLayoutRequest_Grid); ok {
+		return x.Grid
+	}
+	return ""
+}
+
+func (x *StartGameFromLayoutRequest) GetReveal() *CellRef {
+	if x != nil {
+		return x.Reveal
+	}
+	return nil
+}
+
+type isStartGameFromLayoutRequest_Layout interface {
+	isStartGameFromLayoutRequest_Layout()
+}
+
+type StartGameFromLayoutRequest_Mines struct {
+	Mines *MineLayout `protobuf:"bytes,3,opt,name=mines,proto3,oneof"`
+}
+
+type StartGameFromLayoutRequest_Grid struct {
+	// One row of cells per line, where '*' is a mine, '.' is a safe cell and 'o' is the cell to reveal when the game
+	// starts. The layers of deeper boards are separated by blank lines.
+	Grid string `protobuf:"bytes,4,opt,name=grid,proto3,oneof"`
+}
+
+func (*StartGameFromLayoutRequest_Mines) isStartGameFromLayoutRequest_Layout() {}
+
+func (*StartGameFromLayoutRequest_Grid) isStartGameFromLayoutRequest_Layout() {}
+
+type StartGameFromLayoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game *Game `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+}
+
+func (x *StartGameFromLayoutResponse) Reset() {
+	*x = StartGameFromLayoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartGameFromLayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameFromLayoutResponse) ProtoMessage() {}
+
+func (x *StartGameFromLayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameFromLayoutResponse.ProtoReflect.Descriptor instead.
+func (*StartGameFromLayoutResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StartGameFromLayoutResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type GetGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId   string  `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId string  `protobuf:"bytes,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Games with players may only be fetched by their players and invitees until they finish, and games in a match until every game in the match has finished. Anyone else must spectate them. Must be the caller if the server authenticates players.
+	Region   *Region `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`                     // The region of the board to return, in every layer. The whole board is returned if unset.
+}
+
+func (x *GetGameRequest) Reset() {
+	*x = GetGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameRequest) ProtoMessage() {}
+
+func (x *GetGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameRequest.ProtoReflect.Descriptor instead.
+func (*GetGameRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetGameRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *GetGameRequest) GetRegion() *Region {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+type GetGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game *Game `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+}
+
+func (x *GetGameResponse) Reset() {
+	*x = GetGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameResponse) ProtoMessage() {}
+
+func (x *GetGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameResponse.ProtoReflect.Descriptor instead.
+func (*GetGameResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetGameResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type ExportGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId   string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Games in a match may only be exported by their own players until every game in the match has finished.
+}
+
+func (x *ExportGameRequest) Reset() {
+	*x = ExportGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGameRequest) ProtoMessage() {}
+
+func (x *ExportGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGameRequest.ProtoReflect.Descriptor instead.
+func (*ExportGameRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExportGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ExportGameRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type ExportGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Board    string `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`        // The game's board in the plain-text board format.
+	Complete bool   `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"` // Whether the board shows where every mine is, which it only does once the game is finished.
+}
+
+func (x *ExportGameResponse) Reset() {
+	*x = ExportGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGameResponse) ProtoMessage() {}
+
+func (x *ExportGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGameResponse.ProtoReflect.Descriptor instead.
+func (*ExportGameResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExportGameResponse) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *ExportGameResponse) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+type ExportReplayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId   string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Games in a match may only be exported by their own players until every game in the match has finished.
+}
+
+func (x *ExportReplayRequest) Reset() {
+	*x = ExportReplayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReplayRequest) ProtoMessage() {}
+
+func (x *ExportReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReplayRequest.ProtoReflect.Descriptor instead.
+func (*ExportReplayRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExportReplayRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ExportReplayRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type ExportReplayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Replay string `protobuf:"bytes,1,opt,name=replay,proto3" json:"replay,omitempty"` // The game's moves in the RAW video format (RawVF). Only available once the game is finished.
+}
+
+func (x *ExportReplayResponse) Reset() {
+	*x = ExportReplayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportReplayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReplayResponse) ProtoMessage() {}
+
+func (x *ExportReplayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReplayResponse.ProtoReflect.Descriptor instead.
+func (*ExportReplayResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportReplayResponse) GetReplay() string {
+	if x != nil {
+		return x.Replay
+	}
+	return ""
+}
+
+type InvitePlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId    string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId  string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // The player sending the invite. Must already be taking part in the game, which must not be in a match.
+	InviteeId string `protobuf:"bytes,3,opt,name=invitee_id,json=inviteeId,proto3" json:"invitee_id,omitempty"`
+}
+
+func (x *InvitePlayerRequest) Reset() {
+	*x = InvitePlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvitePlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitePlayerRequest) ProtoMessage() {}
+
+func (x *InvitePlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitePlayerRequest.ProtoReflect.Descriptor instead.
+func (*InvitePlayerRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *InvitePlayerRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *InvitePlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *InvitePlayerRequest) GetInviteeId() string {
+	if x != nil {
+		return x.InviteeId
+	}
+	return ""
+}
+
+type InvitePlayerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game *Game `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+}
+
+func (x *InvitePlayerResponse) Reset() {
+	*x = InvitePlayerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvitePlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitePlayerResponse) ProtoMessage() {}
+
+func (x *InvitePlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitePlayerResponse.ProtoReflect.Descriptor instead.
+func (*InvitePlayerResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *InvitePlayerResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type JoinGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId   string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Must have been invited to the game.
+}
+
+func (x *JoinGameRequest) Reset() {
+	*x = JoinGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGameRequest) ProtoMessage() {}
+
+func (x *JoinGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGameRequest.ProtoReflect.Descriptor instead.
+func (*JoinGameRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *JoinGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type JoinGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game *Game `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+}
+
+func (x *JoinGameResponse) Reset() {
+	*x = JoinGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGameResponse) ProtoMessage() {}
+
+func (x *JoinGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGameResponse.ProtoReflect.Descriptor instead.
+func (*JoinGameResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *JoinGameResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+// How far a player has got through their game in a match. Never reveals where the mines are.
+type MatchProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId      string    `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	GameId        string    `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	State         GameState `protobuf:"varint,3,opt,name=state,proto3,enum=sweeper.v1.GameState" json:"state,omitempty"`
+	CellsRevealed int32     `protobuf:"varint,4,opt,name=cells_revealed,json=cellsRevealed,proto3" json:"cells_revealed,omitempty"`
+	FlagsPlaced   int32     `protobuf:"varint,5,opt,name=flags_placed,json=flagsPlaced,proto3" json:"flags_placed,omitempty"`
+}
+
+func (x *MatchProgress) Reset() {
+	*x = MatchProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchProgress) ProtoMessage() {}
+
+func (x *MatchProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchProgress.ProtoReflect.Descriptor instead.
+func (*MatchProgress) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MatchProgress) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *MatchProgress) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *MatchProgress) GetState() GameState {
+	if x != nil {
+		return x.State
+	}
+	return GameState_GAME_STATE_UNKNOWN
+}
+
+func (x *MatchProgress) GetCellsRevealed() int32 {
+	if x != nil {
+		return x.CellsRevealed
+	}
+	return 0
+}
+
+func (x *MatchProgress) GetFlagsPlaced() int32 {
+	if x != nil {
+		return x.FlagsPlaced
+	}
+	return 0
+}
+
+type Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Board    *Board           `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"` // Every player's game is played on an identical copy of this board.
+	Players  []*MatchProgress `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	WinnerId string           `protobuf:"bytes,4,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"` // Unset until the match is decided, and remains unset if every player lost.
+	Finished bool             `protobuf:"varint,5,opt,name=finished,proto3" json:"finished,omitempty"`
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Match) ProtoMessage() {}
+
+func (x *Match) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Match) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Match) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *Match) GetPlayers() []*MatchProgress {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Match) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *Match) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+type StartMatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Board     *Board   `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	PlayerIds []string `protobuf:"bytes,2,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"` // At least 2 players are required.
+}
+
+func (x *StartMatchRequest) Reset() {
+	*x = StartMatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMatchRequest) ProtoMessage() {}
+
+func (x *StartMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMatchRequest.ProtoReflect.Descriptor instead.
+func (*StartMatchRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StartMatchRequest) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *StartMatchRequest) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+type StartMatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Match *Match `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+}
+
+func (x *StartMatchResponse) Reset() {
+	*x = StartMatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMatchResponse) ProtoMessage() {}
+
+func (x *StartMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMatchResponse.ProtoReflect.Descriptor instead.
+func (*StartMatchResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StartMatchResponse) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+type GetMatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MatchId  string `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Matches may only be fetched by their own players until they finish.
+}
+
+func (x *GetMatchRequest) Reset() {
+	*x = GetMatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchRequest) ProtoMessage() {}
+
+func (x *GetMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchRequest.ProtoReflect.Descriptor instead.
+func (*GetMatchRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetMatchRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *GetMatchRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GetMatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Match *Match `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+}
+
+func (x *GetMatchResponse) Reset() {
+	*x = GetMatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchResponse) ProtoMessage() {}
+
+func (x *GetMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchResponse.ProtoReflect.Descriptor instead.
+func (*GetMatchResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetMatchResponse) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+// The kind of game a player is queueing for. Players are only paired with others queueing for an identical preset.
+type Preset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Board   *Board    `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Mode    LobbyMode `protobuf:"varint,2,opt,name=mode,proto3,enum=sweeper.v1.LobbyMode" json:"mode,omitempty"`
+	Players int32     `protobuf:"varint,3,opt,name=players,proto3" json:"players,omitempty"` // At least 2 players are required.
+}
+
+func (x *Preset) Reset() {
+	*x = Preset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Preset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preset) ProtoMessage() {}
+
+func (x *Preset) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Preset.ProtoReflect.Descriptor instead.
+func (*Preset) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Preset) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *Preset) GetMode() LobbyMode {
+	if x != nil {
+		return x.Mode
+	}
+	return LobbyMode_LOBBY_MODE_UNKNOWN
+}
+
+func (x *Preset) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+type QueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string  `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Preset   *Preset `protobuf:"bytes,2,opt,name=preset,proto3" json:"preset,omitempty"`
+}
+
+func (x *QueueRequest) Reset() {
+	*x = QueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueRequest) ProtoMessage() {}
+
+func (x *QueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueRequest.ProtoReflect.Descriptor instead.
+func (*QueueRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *QueueRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *QueueRequest) GetPreset() *Preset {
+	if x != nil {
+		return x.Preset
+	}
+	return nil
+}
+
+type Queued struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayersWaiting int32 `protobuf:"varint,1,opt,name=players_waiting,json=playersWaiting,proto3" json:"players_waiting,omitempty"` // How many other players were already waiting for the preset.
+}
+
+func (x *Queued) Reset() {
+	*x = Queued{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Queued) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Queued) ProtoMessage() {}
+
+func (x *Queued) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Queued.ProtoReflect.Descriptor instead.
+func (*Queued) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Queued) GetPlayersWaiting() int32 {
+	if x != nil {
+		return x.PlayersWaiting
+	}
+	return 0
+}
+
+type Paired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId    string   `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	MatchId   string   `protobuf:"bytes,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"` // Only set for versus games.
+	PlayerIds []string `protobuf:"bytes,3,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+}
+
+func (x *Paired) Reset() {
+	*x = Paired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Paired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Paired) ProtoMessage() {}
+
+func (x *Paired) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Paired.ProtoReflect.Descriptor instead.
+func (*Paired) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Paired) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *Paired) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *Paired) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+type QueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Status:
+	//
+	//	*QueueResponse_Queued
+	//	*QueueResponse_Paired
+	Status isQueueResponse_Status `protobuf_oneof:"status"`
+}
+
+func (x *QueueResponse) Reset() {
+	*x = QueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueResponse) ProtoMessage() {}
+
+func (x *QueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueResponse.ProtoReflect.Descriptor instead.
+func (*QueueResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{39}
+}
+
+func (m *QueueResponse) GetStatus() isQueueResponse_Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (x *QueueResponse) GetQueued() *Queued {
+	if x, ok := x.GetStatus().(*QueueResponse_Queued); ok {
+		return x.Queued
+	}
+	return nil
+}
+
+func (x *QueueResponse) GetPaired() *Paired {
+	if x, ok := x.GetStatus().(*QueueResponse_Paired); ok {
+		return x.Paired
+	}
+	return nil
+}
+
+type isQueueResponse_Status interface {
+	isQueueResponse_Status()
+}
+
+type QueueResponse_Queued struct {
+	Queued *Queued `protobuf:"bytes,1,opt,name=queued,proto3,oneof"`
+}
+
+type QueueResponse_Paired struct {
+	Paired *Paired `protobuf:"bytes,2,opt,name=paired,proto3,oneof"`
+}
+
+func (*QueueResponse_Queued) isQueueResponse_Status() {}
+
+func (*QueueResponse_Paired) isQueueResponse_Status() {}
+
+type Heat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MatchId  string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	GameIds  []string               `protobuf:"bytes,2,rep,name=game_ids,json=gameIds,proto3" json:"game_ids,omitempty"`    // In the same order as the pairing's players.
+	Times    []*durationpb.Duration `protobuf:"bytes,3,rep,name=times,proto3" json:"times,omitempty"`                       // How long each player took to win. Unset if they didn't.
+	WinnerId string                 `protobuf:"bytes,4,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"` // Unset if nobody won the heat, in which case it is replayed.
+	Finished bool                   `protobuf:"varint,5,opt,name=finished,proto3" json:"finished,omitempty"`
+}
+
+func (x *Heat) Reset() {
+	*x = Heat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Heat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Heat) ProtoMessage() {}
+
+func (x *Heat) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Heat.ProtoReflect.Descriptor instead.
+func (*Heat) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Heat) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *Heat) GetGameIds() []string {
+	if x != nil {
+		return x.GameIds
+	}
+	return nil
+}
+
+func (x *Heat) GetTimes() []*durationpb.Duration {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+func (x *Heat) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *Heat) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+type Pairing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerIds []string `protobuf:"bytes,1,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"` // A single player has been given a bye.
+	Heats     []*Heat  `protobuf:"bytes,2,rep,name=heats,proto3" json:"heats,omitempty"`
+	Wins      []int32  `protobuf:"varint,3,rep,packed,name=wins,proto3" json:"wins,omitempty"` // In the same order as player_ids.
+	WinnerId  string   `protobuf:"bytes,4,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+}
+
+func (x *Pairing) Reset() {
+	*x = Pairing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pairing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pairing) ProtoMessage() {}
+
+func (x *Pairing) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pairing.ProtoReflect.Descriptor instead.
+func (*Pairing) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Pairing) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *Pairing) GetHeats() []*Heat {
+	if x != nil {
+		return x.Heats
+	}
+	return nil
+}
+
+func (x *Pairing) GetWins() []int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return nil
+}
+
+func (x *Pairing) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+type Round struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairings []*Pairing `protobuf:"bytes,1,rep,name=pairings,proto3" json:"pairings,omitempty"`
+}
+
+func (x *Round) Reset() {
+	*x = Round{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Round) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Round) ProtoMessage() {}
+
+func (x *Round) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Round.ProtoReflect.Descriptor instead.
+func (*Round) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Round) GetPairings() []*Pairing {
+	if x != nil {
+		return x.Pairings
+	}
+	return nil
+}
+
+type Tournament struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganiserId string           `protobuf:"bytes,2,opt,name=organiser_id,json=organiserId,proto3" json:"organiser_id,omitempty"`
+	Board       *Board           `protobuf:"bytes,3,opt,name=board,proto3" json:"board,omitempty"`
+	Format      TournamentFormat `protobuf:"varint,4,opt,name=format,proto3,enum=sweeper.v1.TournamentFormat" json:"format,omitempty"`
+	BestOf      int32            `protobuf:"varint,5,opt,name=best_of,json=bestOf,proto3" json:"best_of,omitempty"`
+	PlayerIds   []string         `protobuf:"bytes,6,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"` // In seed order.
+	Rounds      []*Round         `protobuf:"bytes,7,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	WinnerId    string           `protobuf:"bytes,8,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+}
+
+func (x *Tournament) Reset() {
+	*x = Tournament{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tournament) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tournament) ProtoMessage() {}
+
+func (x *Tournament) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tournament.ProtoReflect.Descriptor instead.
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Tournament) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tournament) GetOrganiserId() string {
+	if x != nil {
+		return x.OrganiserId
+	}
+	return ""
+}
+
+func (x *Tournament) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *Tournament) GetFormat() TournamentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TournamentFormat_TOURNAMENT_FORMAT_UNKNOWN
+}
+
+func (x *Tournament) GetBestOf() int32 {
+	if x != nil {
+		return x.BestOf
+	}
+	return 0
+}
+
+func (x *Tournament) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *Tournament) GetRounds() []*Round {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+func (x *Tournament) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+type CreateTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrganiserId string           `protobuf:"bytes,1,opt,name=organiser_id,json=organiserId,proto3" json:"organiser_id,omitempty"`
+	Board       *Board           `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Format      TournamentFormat `protobuf:"varint,3,opt,name=format,proto3,enum=sweeper.v1.TournamentFormat" json:"format,omitempty"`
+	BestOf      int32            `protobuf:"varint,4,opt,name=best_of,json=bestOf,proto3" json:"best_of,omitempty"`
+	PlayerIds   []string         `protobuf:"bytes,5,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"` // In seed order. The highest seeds are given byes first.
+}
+
+func (x *CreateTournamentRequest) Reset() {
+	*x = CreateTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentRequest) ProtoMessage() {}
+
+func (x *CreateTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateTournamentRequest) GetOrganiserId() string {
+	if x != nil {
+		return x.OrganiserId
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *CreateTournamentRequest) GetFormat() TournamentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TournamentFormat_TOURNAMENT_FORMAT_UNKNOWN
+}
+
+func (x *CreateTournamentRequest) GetBestOf() int32 {
+	if x != nil {
+		return x.BestOf
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+type CreateTournamentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tournament *Tournament `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+}
+
+func (x *CreateTournamentResponse) Reset() {
+	*x = CreateTournamentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTournamentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentResponse) ProtoMessage() {}
+
+func (x *CreateTournamentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentResponse.ProtoReflect.Descriptor instead.
+func (*CreateTournamentResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateTournamentResponse) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+type GetTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+}
+
+func (x *GetTournamentRequest) Reset() {
+	*x = GetTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentRequest) ProtoMessage() {}
+
+func (x *GetTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentRequest.ProtoReflect.Descriptor instead.
+func (*GetTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetTournamentRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type GetTournamentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tournament *Tournament `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+}
+
+func (x *GetTournamentResponse) Reset() {
+	*x = GetTournamentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTournamentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentResponse) ProtoMessage() {}
+
+func (x *GetTournamentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentResponse.ProtoReflect.Descriptor instead.
+func (*GetTournamentResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetTournamentResponse) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+type SpectateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *SpectateRequest) Reset() {
+	*x = SpectateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpectateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateRequest) ProtoMessage() {}
+
+func (x *SpectateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateRequest.ProtoReflect.Descriptor instead.
+func (*SpectateRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SpectateRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type SpectateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game *Game `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"` // Exactly what the game's players can see. The server may delay each update, though players can only be kept from skipping the delay if the server authenticates them.
+}
+
+func (x *SpectateResponse) Reset() {
+	*x = SpectateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpectateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateResponse) ProtoMessage() {}
+
+func (x *SpectateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateResponse.ProtoReflect.Descriptor instead.
+func (*SpectateResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SpectateResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+// A rectangle of cells. Its rows and columns count down and to the right from its top left cell.
+type Region struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Top     int32 `protobuf:"varint,1,opt,name=top,proto3" json:"top,omitempty"`
+	Left    int32 `protobuf:"varint,2,opt,name=left,proto3" json:"left,omitempty"`
+	Rows    int32 `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
+	Columns int32 `protobuf:"varint,4,opt,name=columns,proto3" json:"columns,omitempty"`
+}
+
+func (x *Region) Reset() {
+	*x = Region{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Region) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Region) ProtoMessage() {}
+
+func (x *Region) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Region.ProtoReflect.Descriptor instead.
+func (*Region) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Region) GetTop() int32 {
+	if x != nil {
+		return x.Top
+	}
+	return 0
+}
+
+func (x *Region) GetLeft() int32 {
+	if x != nil {
+		return x.Left
+	}
+	return 0
+}
+
+func (x *Region) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *Region) GetColumns() int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+// A game played on a board with no edges, generated one chunk at a time as it is explored. It is never won: the
+// player scores a point for each safe cell they reveal, until they reveal a mine or end the game. Moves may change
+// cells in at most 1024 chunks: moves that would change cells in another are rejected.
+type EndlessGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State         GameState              `protobuf:"varint,2,opt,name=state,proto3,enum=sweeper.v1.GameState" json:"state,omitempty"`
+	MinesPerChunk int32                  `protobuf:"varint,3,opt,name=mines_per_chunk,json=minesPerChunk,proto3" json:"mines_per_chunk,omitempty"` // How many mines each 16x16 chunk of the board holds.
+	Cleared       int32                  `protobuf:"varint,4,opt,name=cleared,proto3" json:"cleared,omitempty"`                                    // How many safe cells have been revealed: the player's score.
+	Region        *Region                `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`                                       // The region of the board cells holds. Cell coordinates may be negative.
+	Cells         []*Cell                `protobuf:"bytes,6,rep,name=cells,proto3" json:"cells,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,7,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // The only player who may make moves. If empty, anyone may.
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+}
+
+func (x *EndlessGame) Reset() {
+	*x = EndlessGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndlessGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndlessGame) ProtoMessage() {}
+
+func (x *EndlessGame) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndlessGame.ProtoReflect.Descriptor instead.
+func (*EndlessGame) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *EndlessGame) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EndlessGame) GetState() GameState {
+	if x != nil {
+		return x.State
+	}
+	return GameState_GAME_STATE_UNKNOWN
+}
+
+func (x *EndlessGame) GetMinesPerChunk() int32 {
+	if x != nil {
+		return x.MinesPerChunk
+	}
+	return 0
+}
+
+func (x *EndlessGame) GetCleared() int32 {
+	if x != nil {
+		return x.Cleared
+	}
+	return 0
+}
+
+func (x *EndlessGame) GetRegion() *Region {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+func (x *EndlessGame) GetCells() []*Cell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *EndlessGame) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *EndlessGame) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+type StartEndlessGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId      string  `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	MinesPerChunk int32   `protobuf:"varint,2,opt,name=mines_per_chunk,json=minesPerChunk,proto3" json:"mines_per_chunk,omitempty"` // Between 32 and 128.
+	Region        *Region `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`                                       // The region of the board to return. Defaults to the chunk holding the opening.
+}
+
+func (x *StartEndlessGameRequest) Reset() {
+	*x = StartEndlessGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartEndlessGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartEndlessGameRequest) ProtoMessage() {}
+
+func (x *StartEndlessGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartEndlessGameRequest.ProtoReflect.Descriptor instead.
+func (*StartEndlessGameRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StartEndlessGameRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *StartEndlessGameRequest) GetMinesPerChunk() int32 {
+	if x != nil {
+		return x.MinesPerChunk
+	}
+	return 0
+}
+
+func (x *StartEndlessGameRequest) GetRegion() *Region {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+type StartEndlessGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game *EndlessGame `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+}
+
+func (x *StartEndlessGameResponse) Reset() {
+	*x = StartEndlessGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartEndlessGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartEndlessGameResponse) ProtoMessage() {}
+
+func (x *StartEndlessGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartEndlessGameResponse.ProtoReflect.Descriptor instead.
+func (*StartEndlessGameResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *StartEndlessGameResponse) GetGame() *EndlessGame {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type GetEndlessGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string  `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Region *Region `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"` // The region of the board to return. Defaults to the chunk holding the opening.
+}
+
+func (x *GetEndlessGameRequest) Reset() {
+	*x = GetEndlessGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEndlessGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEndlessGameRequest) ProtoMessage() {}
+
+func (x *GetEndlessGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEndlessGameRequest.ProtoReflect.Descriptor instead.
+func (*GetEndlessGameRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetEndlessGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetEndlessGameRequest) GetRegion() *Region {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+type GetEndlessGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game *EndlessGame `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+}
+
+func (x *GetEndlessGameResponse) Reset() {
+	*x = GetEndlessGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEndlessGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEndlessGameResponse) ProtoMessage() {}
+
+func (x *GetEndlessGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEndlessGameResponse.ProtoReflect.Descriptor instead.
+func (*GetEndlessGameResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetEndlessGameResponse) GetGame() *EndlessGame {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type MakeEndlessMoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId   string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Types that are assignable to Move:
+	//
+	//	*MakeEndlessMoveRequest_End
+	//	*MakeEndlessMoveRequest_Cell
+	Move   isMakeEndlessMoveRequest_Move `protobuf_oneof:"move"`
+	Region *Region                       `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"` // The region of the board to return. Defaults to the chunk holding the opening.
+}
+
+func (x *MakeEndlessMoveRequest) Reset() {
+	*x = MakeEndlessMoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MakeEndlessMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeEndlessMoveRequest) ProtoMessage() {}
+
+func (x *MakeEndlessMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeEndlessMoveRequest.ProtoReflect.Descriptor instead.
+func (*MakeEndlessMoveRequest) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MakeEndlessMoveRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *MakeEndlessMoveRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (m *MakeEndlessMoveRequest) GetMove() isMakeEndlessMoveRequest_Move {
+	if m != nil {
+		return m.Move
+	}
+	return nil
+}
+
+func (x *MakeEndlessMoveRequest) GetEnd() *emptypb.Empty {
+	if x, ok := x.GetMove().(*MakeEndlessMoveRequest_End); ok {
+		return x.End
+	}
+	return nil
+}
+
+func (x *MakeEndlessMoveRequest) GetCell() *CellMove {
+	if x, ok := x.GetMove().(*MakeEndlessMoveRequest_Cell); ok {
+		return x.Cell
+	}
+	return nil
+}
+
+func (x *MakeEndlessMoveRequest) GetRegion() *Region {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+type isMakeEndlessMoveRequest_Move interface {
+	isMakeEndlessMoveRequest_Move()
+}
+
+type MakeEndlessMoveRequest_End struct {
+	End *emptypb.Empty `protobuf:"bytes,3,opt,name=end,proto3,oneof"`
+}
+
+type MakeEndlessMoveRequest_Cell struct {
+	Cell *CellMove `protobuf:"bytes,4,opt,name=cell,proto3,oneof"` // The cell's layer is ignored.
+}
+
+func (*MakeEndlessMoveRequest_End) isMakeEndlessMoveRequest_Move() {}
+
+func (*MakeEndlessMoveRequest_Cell) isMakeEndlessMoveRequest_Move() {}
+
+type MakeEndlessMoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game *EndlessGame `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+}
+
+func (x *MakeEndlessMoveResponse) Reset() {
+	*x = MakeEndlessMoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sweeper_v1_sweeper_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MakeEndlessMoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeEndlessMoveResponse) ProtoMessage() {}
+
+func (x *MakeEndlessMoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sweeper_v1_sweeper_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeEndlessMoveResponse.ProtoReflect.Descriptor instead.
+func (*MakeEndlessMoveResponse) Descriptor() ([]byte, []int) {
+	return file_sweeper_v1_sweeper_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MakeEndlessMoveResponse) GetGame() *EndlessGame {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+var File_sweeper_v1_sweeper_proto protoreflect.FileDescriptor
+
 var file_sweeper_v1_sweeper_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x77, 0x65,
 	0x65, 0x70, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x73, 0x77, 0x65, 0x65,
-	0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x42, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x76, 0x65,
-	0x61, 0x6c, 0x65, 0x64, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x6e, 0x65, 0x69, 0x67,
-	0x68, 0x62, 0x6f, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x69,
-	0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x7c, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x65, 0x61,
-	0x6c, 0x65, 0x64, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x35, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65,
-	0x64, 0x43, 0x65, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x2c,
-	0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x65, 0x42, 0x07, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x99, 0x02, 0x0a, 0x04, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x10,
-	0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77,
-	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x38, 0x0a, 0x0a, 0x75, 0x6e, 0x72, 0x65,
-	0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x6e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c,
-	0x65, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x07, 0x66,
-	0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x64,
-	0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x43, 0x65, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x08,
-	0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x22, 0x4b, 0x0a, 0x05, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x94,
-	0x01, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x26, 0x0a,
-	0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
-	0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05,
-	0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x68, 0x0a, 0x08, 0x43, 0x65, 0x6c, 0x6c, 0x4d, 0x6f, 0x76,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
-	0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x77,
-	0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x4d, 0x6f, 0x76,
-	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x8a, 0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x03,
-	0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x42, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x76,
+	0x65, 0x61, 0x6c, 0x65, 0x64, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x6e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72,
+	0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x7c, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x65, 0x64, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x35, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c,
+	0x65, 0x64, 0x43, 0x65, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x12,
+	0x2c, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x65, 0x42, 0x07, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc5, 0x02, 0x0a, 0x04, 0x43, 0x65, 0x6c, 0x6c, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f,
+	0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x38, 0x0a, 0x0a, 0x75, 0x6e, 0x72,
+	0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x6e, 0x72, 0x65, 0x76, 0x65, 0x61,
+	0x6c, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x07,
+	0x66, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x65,
+	0x64, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x43, 0x65, 0x6c, 0x6c, 0x48, 0x00, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xb7,
+	0x02, 0x0a, 0x05, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x08,
+	0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x72, 0x61, 0x70,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x72, 0x61, 0x70, 0x12, 0x2b, 0x0a, 0x12,
+	0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x65,
+	0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e,
+	0x65, 0x73, 0x50, 0x65, 0x72, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12,
+	0x27, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x4f,
+	0x70, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xaa, 0x04, 0x0a, 0x04,
+	0x47, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x27, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x26, 0x0a, 0x05, 0x63, 0x65,
+	0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74,
+	0x75, 0x72, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x75, 0x72, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x80, 0x01, 0x0a, 0x0b, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x65, 0x6c, 0x6c, 0x73, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c,
+	0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x69, 0x6e,
+	0x65, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x7e, 0x0a, 0x08, 0x43,
+	0x65, 0x6c, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x65, 0x6c, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0xd3, 0x01, 0x0a, 0x0f,
+	0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x65, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c,
+	0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x48, 0x00, 0x52, 0x04, 0x63, 0x65, 0x6c, 0x6c, 0x12, 0x2a, 0x0a,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x6d, 0x6f, 0x76,
+	0x65, 0x22, 0x38, 0x0a, 0x10, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x22, 0x74, 0x0a, 0x10, 0x4d,
+	0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65,
+	0x73, 0x22, 0x53, 0x0a, 0x11, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x22, 0x23, 0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x58, 0x0a, 0x10, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x67, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65,
+	0x22, 0x49, 0x0a, 0x07, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0x37, 0x0a, 0x0a, 0x4d,
+	0x69, 0x6e, 0x65, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x69, 0x6e,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x52, 0x05, 0x6d,
+	0x69, 0x6e, 0x65, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x1a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x27, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x6d, 0x69, 0x6e,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x48, 0x00, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x04, 0x67, 0x72, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12,
+	0x2b, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c,
+	0x6c, 0x52, 0x65, 0x66, 0x52, 0x06, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x42, 0x08, 0x0a, 0x06,
+	0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x22, 0x43, 0x0a, 0x1b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x22, 0x72, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22,
+	0x37, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x4b, 0x0a, 0x13, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x22, 0x6a, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x65, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x14, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04,
+	0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x77, 0x65,
+	0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61,
+	0x6d, 0x65, 0x22, 0x47, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x10, 0x4a,
+	0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x04, 0x67, 0x61, 0x6d, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73,
+	0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x65,
+	0x6c, 0x6c, 0x73, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27,
+	0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x22, 0x5b, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x22, 0x3d, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x22, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x76, 0x0a, 0x06, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x29, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x77, 0x65,
+	0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x22, 0x57, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0x31, 0x0a, 0x06, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x5f,
+	0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x5b, 0x0a,
+	0x06, 0x50, 0x61, 0x69, 0x72, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x75, 0x0a, 0x0d, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x77,
+	0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x70, 0x61, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x06, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0xa6, 0x01, 0x0a, 0x04, 0x48, 0x65, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x73,
+	0x12, 0x2f, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x07, 0x50,
+	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x68, 0x65, 0x61, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x52, 0x05, 0x68, 0x65, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x38,
+	0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08,
+	0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x9e, 0x02, 0x0a, 0x0a, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x65, 0x73,
+	0x74, 0x5f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x65, 0x73, 0x74,
+	0x4f, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd3, 0x01, 0x0a, 0x17, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x65, 0x73, 0x74, 0x5f,
+	0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x65, 0x73, 0x74, 0x4f, 0x66,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22,
+	0x52, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x3b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x4f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x2a, 0x0a, 0x0f, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x38, 0x0a,
+	0x10, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x74, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0xb8, 0x02, 0x0a, 0x0b, 0x45, 0x6e, 0x64, 0x6c, 0x65, 0x73,
+	0x73, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x69, 0x6e,
+	0x65, 0x73, 0x50, 0x65, 0x72, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c,
+	0x65, 0x61, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6c, 0x65,
+	0x61, 0x72, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c,
+	0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x8a, 0x01, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x6c, 0x65, 0x73,
+	0x73, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x65, 0x73, 0x50, 0x65, 0x72, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x47, 0x0a,
+	0x18, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x67, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64,
+	0x6c, 0x65, 0x73, 0x73, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x6c, 0x65,
+	0x73, 0x73, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73,
+	0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x64, 0x6c, 0x65, 0x73,
+	0x73, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x16,
+	0x4d, 0x61, 0x6b, 0x65, 0x45, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x03,
+	0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
 	0x79, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x65, 0x6c, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72,
 	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x48, 0x00, 0x52, 0x04,
-	0x63, 0x65, 0x6c, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x38, 0x0a, 0x10,
-	0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x24, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65,
-	0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x77, 0x65, 0x65,
-	0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x22, 0x39, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x22, 0x29,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04,
-	0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x77, 0x65,
-	0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61,
-	0x6d, 0x65, 0x2a, 0x69, 0x0a, 0x15, 0x55, 0x6e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64,
-	0x43, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x1f, 0x55,
-	0x4e, 0x52, 0x45, 0x56, 0x45, 0x41, 0x4c, 0x45, 0x44, 0x5f, 0x43, 0x45, 0x4c, 0x4c, 0x5f, 0x4d,
-	0x41, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
-	0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x10, 0x01,
-	0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x41, 0x47, 0x47, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a,
-	0x0a, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x51, 0x0a,
-	0x09, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x41,
-	0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x4e, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
-	0x07, 0x0a, 0x03, 0x57, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x53, 0x54,
-	0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x04,
-	0x2a, 0x5d, 0x0a, 0x0e, 0x43, 0x65, 0x6c, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x45, 0x4c, 0x4c, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x5f,
-	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
-	0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x46,
-	0x4c, 0x41, 0x47, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x56, 0x45, 0x41, 0x4c, 0x10, 0x04, 0x32,
-	0xe5, 0x01, 0x0a, 0x0e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12,
-	0x1c, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x63, 0x65, 0x6c, 0x6c, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x42, 0x06, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x46, 0x0a, 0x17, 0x4d, 0x61, 0x6b, 0x65,
+	0x45, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65,
+	0x2a, 0x69, 0x0a, 0x15, 0x55, 0x6e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x43, 0x65,
+	0x6c, 0x6c, 0x4d, 0x61, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x1f, 0x55, 0x4e, 0x52,
+	0x45, 0x56, 0x45, 0x41, 0x4c, 0x45, 0x44, 0x5f, 0x43, 0x45, 0x4c, 0x4c, 0x5f, 0x4d, 0x41, 0x52,
+	0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e,
+	0x0a, 0x0a, 0x4e, 0x4f, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x46, 0x4c, 0x41, 0x47, 0x47, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x43, 0x0a, 0x08, 0x54,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x4f, 0x50, 0x4f, 0x4c,
+	0x4f, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x53, 0x51, 0x55, 0x41, 0x52, 0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x45, 0x58,
+	0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x52, 0x49, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x03,
+	0x2a, 0x37, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x55, 0x4c,
+	0x45, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x43, 0x4c, 0x41, 0x53, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x49, 0x4e,
+	0x45, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x53, 0x10, 0x02, 0x2a, 0x6b, 0x0a, 0x09, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x4f, 0x4e, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x57,
+	0x4f, 0x4e, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x53, 0x54, 0x10, 0x03, 0x12, 0x0c,
+	0x0a, 0x08, 0x52, 0x45, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09,
+	0x54, 0x49, 0x4d, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x44,
+	0x52, 0x41, 0x57, 0x4e, 0x10, 0x06, 0x2a, 0x68, 0x0a, 0x0e, 0x43, 0x65, 0x6c, 0x6c, 0x4d, 0x6f,
+	0x76, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x45, 0x4c, 0x4c,
+	0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x4c, 0x41, 0x47, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x56,
+	0x45, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x48, 0x4f, 0x52, 0x44, 0x10, 0x05,
+	0x2a, 0x39, 0x0a, 0x09, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x12, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x45, 0x52, 0x53, 0x55, 0x53, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x4f, 0x4f, 0x50, 0x10, 0x02, 0x2a, 0x50, 0x0a, 0x10, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x42, 0x45, 0x53, 0x54, 0x5f, 0x4f, 0x46, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x46,
+	0x41, 0x53, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02, 0x32, 0xb7, 0x0b,
+	0x0a, 0x0e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x48, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x2e,
 	0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x07,
-	0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x45, 0x0a, 0x08, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x1b, 0x2e, 0x73,
-	0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f,
-	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x77, 0x65, 0x65,
-	0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x77,
+	0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x12, 0x26, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x2e,
+	0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f,
+	0x76, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6b,
+	0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a,
+	0x09, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x77, 0x65,
+	0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x12, 0x1f, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x4a, 0x6f,
+	0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x47, 0x0a, 0x08, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e,
+	0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x77, 0x65,
+	0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0a, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d,
+	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x23, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20,
+	0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x18, 0x2e, 0x73,
+	0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x30, 0x01, 0x12, 0x5d, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x6c,
+	0x65, 0x73, 0x73, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x6c, 0x65, 0x73,
+	0x73, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73,
+	0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45,
+	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x73,
+	0x47, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x4d,
+	0x61, 0x6b, 0x65, 0x45, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x22,
+	0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6b, 0x65,
+	0x45, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x6b, 0x65, 0x45, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x76, 0x65, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75,
 	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x6d, 0x61, 0x72, 0x6c, 0x69,
 	0x6e, 0x2f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x77,
@@ -1092,54 +4755,189 @@ func file_sweeper_v1_sweeper_proto_rawDescGZIP() []byte {
 	return file_sweeper_v1_sweeper_proto_rawDescData
 }
 
-var file_sweeper_v1_sweeper_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_sweeper_v1_sweeper_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_sweeper_v1_sweeper_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_sweeper_v1_sweeper_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_sweeper_v1_sweeper_proto_goTypes = []any{
-	(UnrevealedCellMarking)(0), // 0: sweeper.v1.UnrevealedCellMarking
-	(GameState)(0),             // 1: sweeper.v1.GameState
-	(CellMoveAction)(0),        // 2: sweeper.v1.CellMoveAction
-	(*ClearRevealedCell)(nil),  // 3: sweeper.v1.ClearRevealedCell
-	(*RevealedCell)(nil),       // 4: sweeper.v1.RevealedCell
-	(*Cell)(nil),               // 5: sweeper.v1.Cell
-	(*Board)(nil),              // 6: sweeper.v1.Board
-	(*Game)(nil),               // 7: sweeper.v1.Game
-	(*CellMove)(nil),           // 8: sweeper.v1.CellMove
-	(*MakeMoveRequest)(nil),    // 9: sweeper.v1.MakeMoveRequest
-	(*MakeMoveResponse)(nil),   // 10: sweeper.v1.MakeMoveResponse
-	(*StartGameRequest)(nil),   // 11: sweeper.v1.StartGameRequest
-	(*StartGameResponse)(nil),  // 12: sweeper.v1.StartGameResponse
-	(*GetGameRequest)(nil),     // 13: sweeper.v1.GetGameRequest
-	(*GetGameResponse)(nil),    // 14: sweeper.v1.GetGameResponse
-	(*emptypb.Empty)(nil),      // 15: google.protobuf.Empty
+	(UnrevealedCellMarking)(0),          // 0: sweeper.v1.UnrevealedCellMarking
+	(Topology)(0),                       // 1: sweeper.v1.Topology
+	(Rules)(0),                          // 2: sweeper.v1.Rules
+	(GameState)(0),                      // 3: sweeper.v1.GameState
+	(CellMoveAction)(0),                 // 4: sweeper.v1.CellMoveAction
+	(LobbyMode)(0),                      // 5: sweeper.v1.LobbyMode
+	(TournamentFormat)(0),               // 6: sweeper.v1.TournamentFormat
+	(*ClearRevealedCell)(nil),           // 7: sweeper.v1.ClearRevealedCell
+	(*RevealedCell)(nil),                // 8: sweeper.v1.RevealedCell
+	(*Cell)(nil),                        // 9: sweeper.v1.Cell
+	(*Board)(nil),                       // 10: sweeper.v1.Board
+	(*Player)(nil),                      // 11: sweeper.v1.Player
+	(*Game)(nil),                        // 12: sweeper.v1.Game
+	(*GameSummary)(nil),                 // 13: sweeper.v1.GameSummary
+	(*CellMove)(nil),                    // 14: sweeper.v1.CellMove
+	(*MakeMoveRequest)(nil),             // 15: sweeper.v1.MakeMoveRequest
+	(*MakeMoveResponse)(nil),            // 16: sweeper.v1.MakeMoveResponse
+	(*MakeMovesRequest)(nil),            // 17: sweeper.v1.MakeMovesRequest
+	(*MakeMovesResponse)(nil),           // 18: sweeper.v1.MakeMovesResponse
+	(*MoveFailure)(nil),                 // 19: sweeper.v1.MoveFailure
+	(*StartGameRequest)(nil),            // 20: sweeper.v1.StartGameRequest
+	(*StartGameResponse)(nil),           // 21: sweeper.v1.StartGameResponse
+	(*CellRef)(nil),                     // 22: sweeper.v1.CellRef
+	(*MineLayout)(nil),                  // 23: sweeper.v1.MineLayout
+	(*StartGameFromLayoutRequest)(nil),  // 24: sweeper.v1.StartGameFromLayoutRequest
+	(*StartGameFromLayoutResponse)(nil), // 25: sweeper.v1.StartGameFromLayoutResponse
+	(*GetGameRequest)(nil),              // 26: sweeper.v1.GetGameRequest
+	(*GetGameResponse)(nil),             // 27: sweeper.v1.GetGameResponse
+	(*ExportGameRequest)(nil),           // 28: sweeper.v1.ExportGameRequest
+	(*ExportGameResponse)(nil),          // 29: sweeper.v1.ExportGameResponse
+	(*ExportReplayRequest)(nil),         // 30: sweeper.v1.ExportReplayRequest
+	(*ExportReplayResponse)(nil),        // 31: sweeper.v1.ExportReplayResponse
+	(*InvitePlayerRequest)(nil),         // 32: sweeper.v1.InvitePlayerRequest
+	(*InvitePlayerResponse)(nil),        // 33: sweeper.v1.InvitePlayerResponse
+	(*JoinGameRequest)(nil),             // 34: sweeper.v1.JoinGameRequest
+	(*JoinGameResponse)(nil),            // 35: sweeper.v1.JoinGameResponse
+	(*MatchProgress)(nil),               // 36: sweeper.v1.MatchProgress
+	(*Match)(nil),                       // 37: sweeper.v1.Match
+	(*StartMatchRequest)(nil),           // 38: sweeper.v1.StartMatchRequest
+	(*StartMatchResponse)(nil),          // 39: sweeper.v1.StartMatchResponse
+	(*GetMatchRequest)(nil),             // 40: sweeper.v1.GetMatchRequest
+	(*GetMatchResponse)(nil),            // 41: sweeper.v1.GetMatchResponse
+	(*Preset)(nil),                      // 42: sweeper.v1.Preset
+	(*QueueRequest)(nil),                // 43: sweeper.v1.QueueRequest
+	(*Queued)(nil),                      // 44: sweeper.v1.Queued
+	(*Paired)(nil),                      // 45: sweeper.v1.Paired
+	(*QueueResponse)(nil),               // 46: sweeper.v1.QueueResponse
+	(*Heat)(nil),                        // 47: sweeper.v1.Heat
+	(*Pairing)(nil),                     // 48: sweeper.v1.Pairing
+	(*Round)(nil),                       // 49: sweeper.v1.Round
+	(*Tournament)(nil),                  // 50: sweeper.v1.Tournament
+	(*CreateTournamentRequest)(nil),     // 51: sweeper.v1.CreateTournamentRequest
+	(*CreateTournamentResponse)(nil),    // 52: sweeper.v1.CreateTournamentResponse
+	(*GetTournamentRequest)(nil),        // 53: sweeper.v1.GetTournamentRequest
+	(*GetTournamentResponse)(nil),       // 54: sweeper.v1.GetTournamentResponse
+	(*SpectateRequest)(nil),             // 55: sweeper.v1.SpectateRequest
+	(*SpectateResponse)(nil),            // 56: sweeper.v1.SpectateResponse
+	(*Region)(nil),                      // 57: sweeper.v1.Region
+	(*EndlessGame)(nil),                 // 58: sweeper.v1.EndlessGame
+	(*StartEndlessGameRequest)(nil),     // 59: sweeper.v1.StartEndlessGameRequest
+	(*StartEndlessGameResponse)(nil),    // 60: sweeper.v1.StartEndlessGameResponse
+	(*GetEndlessGameRequest)(nil),       // 61: sweeper.v1.GetEndlessGameRequest
+	(*GetEndlessGameResponse)(nil),      // 62: sweeper.v1.GetEndlessGameResponse
+	(*MakeEndlessMoveRequest)(nil),      // 63: sweeper.v1.MakeEndlessMoveRequest
+	(*MakeEndlessMoveResponse)(nil),     // 64: sweeper.v1.MakeEndlessMoveResponse
+	(*emptypb.Empty)(nil),               // 65: google.protobuf.Empty
+	(*durationpb.Duration)(nil),         // 66: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),       // 67: google.protobuf.Timestamp
 }
 var file_sweeper_v1_sweeper_proto_depIdxs = []int32{
-	3,  // 0: sweeper.v1.RevealedCell.clear:type_name -> sweeper.v1.ClearRevealedCell
-	15, // 1: sweeper.v1.RevealedCell.mine:type_name -> google.protobuf.Empty
-	15, // 2: sweeper.v1.Cell.unrevealed:type_name -> google.protobuf.Empty
-	15, // 3: sweeper.v1.Cell.flagged:type_name -> google.protobuf.Empty
-	15, // 4: sweeper.v1.Cell.questioned:type_name -> google.protobuf.Empty
-	4,  // 5: sweeper.v1.Cell.revealed:type_name -> sweeper.v1.RevealedCell
-	1,  // 6: sweeper.v1.Game.state:type_name -> sweeper.v1.GameState
-	6,  // 7: sweeper.v1.Game.board:type_name -> sweeper.v1.Board
-	5,  // 8: sweeper.v1.Game.cells:type_name -> sweeper.v1.Cell
-	2,  // 9: sweeper.v1.CellMove.action:type_name -> sweeper.v1.CellMoveAction
-	15, // 10: sweeper.v1.MakeMoveRequest.end:type_name -> google.protobuf.Empty
-	8,  // 11: sweeper.v1.MakeMoveRequest.cell:type_name -> sweeper.v1.CellMove
-	7,  // 12: sweeper.v1.MakeMoveResponse.game:type_name -> sweeper.v1.Game
-	6,  // 13: sweeper.v1.StartGameRequest.board:type_name -> sweeper.v1.Board
-	7,  // 14: sweeper.v1.StartGameResponse.game:type_name -> sweeper.v1.Game
-	7,  // 15: sweeper.v1.GetGameResponse.game:type_name -> sweeper.v1.Game
-	11, // 16: sweeper.v1.SweeperService.StartGame:input_type -> sweeper.v1.StartGameRequest
-	13, // 17: sweeper.v1.SweeperService.GetGame:input_type -> sweeper.v1.GetGameRequest
-	9,  // 18: sweeper.v1.SweeperService.MakeMove:input_type -> sweeper.v1.MakeMoveRequest
-	12, // 19: sweeper.v1.SweeperService.StartGame:output_type -> sweeper.v1.StartGameResponse
-	14, // 20: sweeper.v1.SweeperService.GetGame:output_type -> sweeper.v1.GetGameResponse
-	10, // 21: sweeper.v1.SweeperService.MakeMove:output_type -> sweeper.v1.MakeMoveResponse
-	19, // [19:22] is the sub-list for method output_type
-	16, // [16:19] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	7,  // 0: sweeper.v1.RevealedCell.clear:type_name -> sweeper.v1.ClearRevealedCell
+	65, // 1: sweeper.v1.RevealedCell.mine:type_name -> google.protobuf.Empty
+	65, // 2: sweeper.v1.Cell.unrevealed:type_name -> google.protobuf.Empty
+	65, // 3: sweeper.v1.Cell.flagged:type_name -> google.protobuf.Empty
+	65, // 4: sweeper.v1.Cell.questioned:type_name -> google.protobuf.Empty
+	8,  // 5: sweeper.v1.Cell.revealed:type_name -> sweeper.v1.RevealedCell
+	66, // 6: sweeper.v1.Board.time_limit:type_name -> google.protobuf.Duration
+	1,  // 7: sweeper.v1.Board.topology:type_name -> sweeper.v1.Topology
+	2,  // 8: sweeper.v1.Board.rules:type_name -> sweeper.v1.Rules
+	3,  // 9: sweeper.v1.Game.state:type_name -> sweeper.v1.GameState
+	10, // 10: sweeper.v1.Game.board:type_name -> sweeper.v1.Board
+	9,  // 11: sweeper.v1.Game.cells:type_name -> sweeper.v1.Cell
+	66, // 12: sweeper.v1.Game.time_remaining:type_name -> google.protobuf.Duration
+	11, // 13: sweeper.v1.Game.players:type_name -> sweeper.v1.Player
+	67, // 14: sweeper.v1.Game.started_at:type_name -> google.protobuf.Timestamp
+	57, // 15: sweeper.v1.Game.region:type_name -> sweeper.v1.Region
+	13, // 16: sweeper.v1.Game.summary:type_name -> sweeper.v1.GameSummary
+	4,  // 17: sweeper.v1.CellMove.action:type_name -> sweeper.v1.CellMoveAction
+	65, // 18: sweeper.v1.MakeMoveRequest.end:type_name -> google.protobuf.Empty
+	14, // 19: sweeper.v1.MakeMoveRequest.cell:type_name -> sweeper.v1.CellMove
+	57, // 20: sweeper.v1.MakeMoveRequest.region:type_name -> sweeper.v1.Region
+	12, // 21: sweeper.v1.MakeMoveResponse.game:type_name -> sweeper.v1.Game
+	14, // 22: sweeper.v1.MakeMovesRequest.moves:type_name -> sweeper.v1.CellMove
+	12, // 23: sweeper.v1.MakeMovesResponse.game:type_name -> sweeper.v1.Game
+	10, // 24: sweeper.v1.StartGameRequest.board:type_name -> sweeper.v1.Board
+	12, // 25: sweeper.v1.StartGameResponse.game:type_name -> sweeper.v1.Game
+	22, // 26: sweeper.v1.MineLayout.mines:type_name -> sweeper.v1.CellRef
+	10, // 27: sweeper.v1.StartGameFromLayoutRequest.board:type_name -> sweeper.v1.Board
+	23, // 28: sweeper.v1.StartGameFromLayoutRequest.mines:type_name -> sweeper.v1.MineLayout
+	22, // 29: sweeper.v1.StartGameFromLayoutRequest.reveal:type_name -> sweeper.v1.CellRef
+	12, // 30: sweeper.v1.StartGameFromLayoutResponse.game:type_name -> sweeper.v1.Game
+	57, // 31: sweeper.v1.GetGameRequest.region:type_name -> sweeper.v1.Region
+	12, // 32: sweeper.v1.GetGameResponse.game:type_name -> sweeper.v1.Game
+	12, // 33: sweeper.v1.InvitePlayerResponse.game:type_name -> sweeper.v1.Game
+	12, // 34: sweeper.v1.JoinGameResponse.game:type_name -> sweeper.v1.Game
+	3,  // 35: sweeper.v1.MatchProgress.state:type_name -> sweeper.v1.GameState
+	10, // 36: sweeper.v1.Match.board:type_name -> sweeper.v1.Board
+	36, // 37: sweeper.v1.Match.players:type_name -> sweeper.v1.MatchProgress
+	10, // 38: sweeper.v1.StartMatchRequest.board:type_name -> sweeper.v1.Board
+	37, // 39: sweeper.v1.StartMatchResponse.match:type_name -> sweeper.v1.Match
+	37, // 40: sweeper.v1.GetMatchResponse.match:type_name -> sweeper.v1.Match
+	10, // 41: sweeper.v1.Preset.board:type_name -> sweeper.v1.Board
+	5,  // 42: sweeper.v1.Preset.mode:type_name -> sweeper.v1.LobbyMode
+	42, // 43: sweeper.v1.QueueRequest.preset:type_name -> sweeper.v1.Preset
+	44, // 44: sweeper.v1.QueueResponse.queued:type_name -> sweeper.v1.Queued
+	45, // 45: sweeper.v1.QueueResponse.paired:type_name -> sweeper.v1.Paired
+	66, // 46: sweeper.v1.Heat.times:type_name -> google.protobuf.Duration
+	47, // 47: sweeper.v1.Pairing.heats:type_name -> sweeper.v1.Heat
+	48, // 48: sweeper.v1.Round.pairings:type_name -> sweeper.v1.Pairing
+	10, // 49: sweeper.v1.Tournament.board:type_name -> sweeper.v1.Board
+	6,  // 50: sweeper.v1.Tournament.format:type_name -> sweeper.v1.TournamentFormat
+	49, // 51: sweeper.v1.Tournament.rounds:type_name -> sweeper.v1.Round
+	10, // 52: sweeper.v1.CreateTournamentRequest.board:type_name -> sweeper.v1.Board
+	6,  // 53: sweeper.v1.CreateTournamentRequest.format:type_name -> sweeper.v1.TournamentFormat
+	50, // 54: sweeper.v1.CreateTournamentResponse.tournament:type_name -> sweeper.v1.Tournament
+	50, // 55: sweeper.v1.GetTournamentResponse.tournament:type_name -> sweeper.v1.Tournament
+	12, // 56: sweeper.v1.SpectateResponse.game:type_name -> sweeper.v1.Game
+	3,  // 57: sweeper.v1.EndlessGame.state:type_name -> sweeper.v1.GameState
+	57, // 58: sweeper.v1.EndlessGame.region:type_name -> sweeper.v1.Region
+	9,  // 59: sweeper.v1.EndlessGame.cells:type_name -> sweeper.v1.Cell
+	67, // 60: sweeper.v1.EndlessGame.started_at:type_name -> google.protobuf.Timestamp
+	57, // 61: sweeper.v1.StartEndlessGameRequest.region:type_name -> sweeper.v1.Region
+	58, // 62: sweeper.v1.StartEndlessGameResponse.game:type_name -> sweeper.v1.EndlessGame
+	57, // 63: sweeper.v1.GetEndlessGameRequest.region:type_name -> sweeper.v1.Region
+	58, // 64: sweeper.v1.GetEndlessGameResponse.game:type_name -> sweeper.v1.EndlessGame
+	65, // 65: sweeper.v1.MakeEndlessMoveRequest.end:type_name -> google.protobuf.Empty
+	14, // 66: sweeper.v1.MakeEndlessMoveRequest.cell:type_name -> sweeper.v1.CellMove
+	57, // 67: sweeper.v1.MakeEndlessMoveRequest.region:type_name -> sweeper.v1.Region
+	58, // 68: sweeper.v1.MakeEndlessMoveResponse.game:type_name -> sweeper.v1.EndlessGame
+	20, // 69: sweeper.v1.SweeperService.StartGame:input_type -> sweeper.v1.StartGameRequest
+	24, // 70: sweeper.v1.SweeperService.StartGameFromLayout:input_type -> sweeper.v1.StartGameFromLayoutRequest
+	26, // 71: sweeper.v1.SweeperService.GetGame:input_type -> sweeper.v1.GetGameRequest
+	15, // 72: sweeper.v1.SweeperService.MakeMove:input_type -> sweeper.v1.MakeMoveRequest
+	17, // 73: sweeper.v1.SweeperService.MakeMoves:input_type -> sweeper.v1.MakeMovesRequest
+	28, // 74: sweeper.v1.SweeperService.ExportGame:input_type -> sweeper.v1.ExportGameRequest
+	30, // 75: sweeper.v1.SweeperService.ExportReplay:input_type -> sweeper.v1.ExportReplayRequest
+	32, // 76: sweeper.v1.SweeperService.InvitePlayer:input_type -> sweeper.v1.InvitePlayerRequest
+	34, // 77: sweeper.v1.SweeperService.JoinGame:input_type -> sweeper.v1.JoinGameRequest
+	55, // 78: sweeper.v1.SweeperService.Spectate:input_type -> sweeper.v1.SpectateRequest
+	38, // 79: sweeper.v1.SweeperService.StartMatch:input_type -> sweeper.v1.StartMatchRequest
+	40, // 80: sweeper.v1.SweeperService.GetMatch:input_type -> sweeper.v1.GetMatchRequest
+	51, // 81: sweeper.v1.SweeperService.CreateTournament:input_type -> sweeper.v1.CreateTournamentRequest
+	53, // 82: sweeper.v1.SweeperService.GetTournament:input_type -> sweeper.v1.GetTournamentRequest
+	43, // 83: sweeper.v1.SweeperService.Queue:input_type -> sweeper.v1.QueueRequest
+	59, // 84: sweeper.v1.SweeperService.StartEndlessGame:input_type -> sweeper.v1.StartEndlessGameRequest
+	61, // 85: sweeper.v1.SweeperService.GetEndlessGame:input_type -> sweeper.v1.GetEndlessGameRequest
+	63, // 86: sweeper.v1.SweeperService.MakeEndlessMove:input_type -> sweeper.v1.MakeEndlessMoveRequest
+	21, // 87: sweeper.v1.SweeperService.StartGame:output_type -> sweeper.v1.StartGameResponse
+	25, // 88: sweeper.v1.SweeperService.StartGameFromLayout:output_type -> sweeper.v1.StartGameFromLayoutResponse
+	27, // 89: sweeper.v1.SweeperService.GetGame:output_type -> sweeper.v1.GetGameResponse
+	16, // 90: sweeper.v1.SweeperService.MakeMove:output_type -> sweeper.v1.MakeMoveResponse
+	18, // 91: sweeper.v1.SweeperService.MakeMoves:output_type -> sweeper.v1.MakeMovesResponse
+	29, // 92: sweeper.v1.SweeperService.ExportGame:output_type -> sweeper.v1.ExportGameResponse
+	31, // 93: sweeper.v1.SweeperService.ExportReplay:output_type -> sweeper.v1.ExportReplayResponse
+	33, // 94: sweeper.v1.SweeperService.InvitePlayer:output_type -> sweeper.v1.InvitePlayerResponse
+	35, // 95: sweeper.v1.SweeperService.JoinGame:output_type -> sweeper.v1.JoinGameResponse
+	56, // 96: sweeper.v1.SweeperService.Spectate:output_type -> sweeper.v1.SpectateResponse
+	39, // 97: sweeper.v1.SweeperService.StartMatch:output_type -> sweeper.v1.StartMatchResponse
+	41, // 98: sweeper.v1.SweeperService.GetMatch:output_type -> sweeper.v1.GetMatchResponse
+	52, // 99: sweeper.v1.SweeperService.CreateTournament:output_type -> sweeper.v1.CreateTournamentResponse
+	54, // 100: sweeper.v1.SweeperService.GetTournament:output_type -> sweeper.v1.GetTournamentResponse
+	46, // 101: sweeper.v1.SweeperService.Queue:output_type -> sweeper.v1.QueueResponse
+	60, // 102: sweeper.v1.SweeperService.StartEndlessGame:output_type -> sweeper.v1.StartEndlessGameResponse
+	62, // 103: sweeper.v1.SweeperService.GetEndlessGame:output_type -> sweeper.v1.GetEndlessGameResponse
+	64, // 104: sweeper.v1.SweeperService.MakeEndlessMove:output_type -> sweeper.v1.MakeEndlessMoveResponse
+	87, // [87:105] is the sub-list for method output_type
+	69, // [69:87] is the sub-list for method input_type
+	69, // [69:69] is the sub-list for extension type_name
+	69, // [69:69] is the sub-list for extension extendee
+	0,  // [0:69] is the sub-list for field type_name
 }
 
 func init() { file_sweeper_v1_sweeper_proto_init() }
@@ -1160,8 +4958,284 @@ func file_sweeper_v1_sweeper_proto_init() {
 				return nil
 			}
 		}
-		file_sweeper_v1_sweeper_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*RevealedCell); i {
+		file_sweeper_v1_sweeper_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*RevealedCell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Cell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Board); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Player); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Game); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*GameSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*CellMove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*MakeMoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*MakeMoveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*MakeMovesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*MakeMovesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*MoveFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*StartGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*StartGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*CellRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*MineLayout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*StartGameFromLayoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*StartGameFromLayoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportReplayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportReplayResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1172,8 +5246,8 @@ func file_sweeper_v1_sweeper_proto_init() {
 				return nil
 			}
 		}
-		file_sweeper_v1_sweeper_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*Cell); i {
+		file_sweeper_v1_sweeper_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*InvitePlayerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1184,8 +5258,8 @@ func file_sweeper_v1_sweeper_proto_init() {
 				return nil
 			}
 		}
-		file_sweeper_v1_sweeper_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*Board); i {
+		file_sweeper_v1_sweeper_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*InvitePlayerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1196,8 +5270,8 @@ func file_sweeper_v1_sweeper_proto_init() {
 				return nil
 			}
 		}
-		file_sweeper_v1_sweeper_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*Game); i {
+		file_sweeper_v1_sweeper_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*JoinGameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1208,8 +5282,8 @@ func file_sweeper_v1_sweeper_proto_init() {
 				return nil
 			}
 		}
-		file_sweeper_v1_sweeper_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*CellMove); i {
+		file_sweeper_v1_sweeper_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*JoinGameResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1220,8 +5294,8 @@ func file_sweeper_v1_sweeper_proto_init() {
 				return nil
 			}
 		}
-		file_sweeper_v1_sweeper_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*MakeMoveRequest); i {
+		file_sweeper_v1_sweeper_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*MatchProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1232,8 +5306,8 @@ func file_sweeper_v1_sweeper_proto_init() {
 				return nil
 			}
 		}
-		file_sweeper_v1_sweeper_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*MakeMoveResponse); i {
+		file_sweeper_v1_sweeper_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*Match); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1244,8 +5318,8 @@ func file_sweeper_v1_sweeper_proto_init() {
 				return nil
 			}
 		}
-		file_sweeper_v1_sweeper_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*StartGameRequest); i {
+		file_sweeper_v1_sweeper_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*StartMatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1256,8 +5330,8 @@ func file_sweeper_v1_sweeper_proto_init() {
 				return nil
 			}
 		}
-		file_sweeper_v1_sweeper_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*StartGameResponse); i {
+		file_sweeper_v1_sweeper_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*StartMatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1268,8 +5342,8 @@ func file_sweeper_v1_sweeper_proto_init() {
 				return nil
 			}
 		}
-		file_sweeper_v1_sweeper_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*GetGameRequest); i {
+		file_sweeper_v1_sweeper_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1280,8 +5354,284 @@ func file_sweeper_v1_sweeper_proto_init() {
 				return nil
 			}
 		}
-		file_sweeper_v1_sweeper_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*GetGameResponse); i {
+		file_sweeper_v1_sweeper_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*Preset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*QueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*Queued); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*Paired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*QueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*Heat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*Pairing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*Round); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*Tournament); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateTournamentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTournamentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTournamentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*SpectateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*SpectateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*Region); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*EndlessGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*StartEndlessGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*StartEndlessGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEndlessGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEndlessGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*MakeEndlessMoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sweeper_v1_sweeper_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*MakeEndlessMoveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1303,17 +5653,29 @@ func file_sweeper_v1_sweeper_proto_init() {
 		(*Cell_Questioned)(nil),
 		(*Cell_Revealed)(nil),
 	}
-	file_sweeper_v1_sweeper_proto_msgTypes[6].OneofWrappers = []any{
+	file_sweeper_v1_sweeper_proto_msgTypes[8].OneofWrappers = []any{
 		(*MakeMoveRequest_End)(nil),
 		(*MakeMoveRequest_Cell)(nil),
 	}
+	file_sweeper_v1_sweeper_proto_msgTypes[17].OneofWrappers = []any{
+		(*StartGameFromLayoutRequest_Mines)(nil),
+		(*StartGameFromLayoutRequest_Grid)(nil),
+	}
+	file_sweeper_v1_sweeper_proto_msgTypes[39].OneofWrappers = []any{
+		(*QueueResponse_Queued)(nil),
+		(*QueueResponse_Paired)(nil),
+	}
+	file_sweeper_v1_sweeper_proto_msgTypes[56].OneofWrappers = []any{
+		(*MakeEndlessMoveRequest_End)(nil),
+		(*MakeEndlessMoveRequest_Cell)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sweeper_v1_sweeper_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   12,
+			NumEnums:      7,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
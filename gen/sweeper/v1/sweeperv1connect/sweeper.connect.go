@@ -36,25 +36,98 @@ const (
 	// SweeperServiceStartGameProcedure is the fully-qualified name of the SweeperService's StartGame
 	// RPC.
 	SweeperServiceStartGameProcedure = "/sweeper.v1.SweeperService/StartGame"
+	// SweeperServiceStartGameFromLayoutProcedure is the fully-qualified name of the SweeperService's
+	// StartGameFromLayout RPC.
+	SweeperServiceStartGameFromLayoutProcedure = "/sweeper.v1.SweeperService/StartGameFromLayout"
 	// SweeperServiceGetGameProcedure is the fully-qualified name of the SweeperService's GetGame RPC.
 	SweeperServiceGetGameProcedure = "/sweeper.v1.SweeperService/GetGame"
 	// SweeperServiceMakeMoveProcedure is the fully-qualified name of the SweeperService's MakeMove RPC.
 	SweeperServiceMakeMoveProcedure = "/sweeper.v1.SweeperService/MakeMove"
+	// SweeperServiceMakeMovesProcedure is the fully-qualified name of the SweeperService's MakeMoves
+	// RPC.
+	SweeperServiceMakeMovesProcedure = "/sweeper.v1.SweeperService/MakeMoves"
+	// SweeperServiceExportGameProcedure is the fully-qualified name of the SweeperService's ExportGame
+	// RPC.
+	SweeperServiceExportGameProcedure = "/sweeper.v1.SweeperService/ExportGame"
+	// SweeperServiceExportReplayProcedure is the fully-qualified name of the SweeperService's
+	// ExportReplay RPC.
+	SweeperServiceExportReplayProcedure = "/sweeper.v1.SweeperService/ExportReplay"
+	// SweeperServiceInvitePlayerProcedure is the fully-qualified name of the SweeperService's
+	// InvitePlayer RPC.
+	SweeperServiceInvitePlayerProcedure = "/sweeper.v1.SweeperService/InvitePlayer"
+	// SweeperServiceJoinGameProcedure is the fully-qualified name of the SweeperService's JoinGame RPC.
+	SweeperServiceJoinGameProcedure = "/sweeper.v1.SweeperService/JoinGame"
+	// SweeperServiceSpectateProcedure is the fully-qualified name of the SweeperService's Spectate RPC.
+	SweeperServiceSpectateProcedure = "/sweeper.v1.SweeperService/Spectate"
+	// SweeperServiceStartMatchProcedure is the fully-qualified name of the SweeperService's StartMatch
+	// RPC.
+	SweeperServiceStartMatchProcedure = "/sweeper.v1.SweeperService/StartMatch"
+	// SweeperServiceGetMatchProcedure is the fully-qualified name of the SweeperService's GetMatch RPC.
+	SweeperServiceGetMatchProcedure = "/sweeper.v1.SweeperService/GetMatch"
+	// SweeperServiceCreateTournamentProcedure is the fully-qualified name of the SweeperService's
+	// CreateTournament RPC.
+	SweeperServiceCreateTournamentProcedure = "/sweeper.v1.SweeperService/CreateTournament"
+	// SweeperServiceGetTournamentProcedure is the fully-qualified name of the SweeperService's
+	// GetTournament RPC.
+	SweeperServiceGetTournamentProcedure = "/sweeper.v1.SweeperService/GetTournament"
+	// SweeperServiceQueueProcedure is the fully-qualified name of the SweeperService's Queue RPC.
+	SweeperServiceQueueProcedure = "/sweeper.v1.SweeperService/Queue"
+	// SweeperServiceStartEndlessGameProcedure is the fully-qualified name of the SweeperService's
+	// StartEndlessGame RPC.
+	SweeperServiceStartEndlessGameProcedure = "/sweeper.v1.SweeperService/StartEndlessGame"
+	// SweeperServiceGetEndlessGameProcedure is the fully-qualified name of the SweeperService's
+	// GetEndlessGame RPC.
+	SweeperServiceGetEndlessGameProcedure = "/sweeper.v1.SweeperService/GetEndlessGame"
+	// SweeperServiceMakeEndlessMoveProcedure is the fully-qualified name of the SweeperService's
+	// MakeEndlessMove RPC.
+	SweeperServiceMakeEndlessMoveProcedure = "/sweeper.v1.SweeperService/MakeEndlessMove"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	sweeperServiceServiceDescriptor         = v1.File_sweeper_v1_sweeper_proto.Services().ByName("SweeperService")
-	sweeperServiceStartGameMethodDescriptor = sweeperServiceServiceDescriptor.Methods().ByName("StartGame")
-	sweeperServiceGetGameMethodDescriptor   = sweeperServiceServiceDescriptor.Methods().ByName("GetGame")
-	sweeperServiceMakeMoveMethodDescriptor  = sweeperServiceServiceDescriptor.Methods().ByName("MakeMove")
+	sweeperServiceServiceDescriptor                   = v1.File_sweeper_v1_sweeper_proto.Services().ByName("SweeperService")
+	sweeperServiceStartGameMethodDescriptor           = sweeperServiceServiceDescriptor.Methods().ByName("StartGame")
+	sweeperServiceStartGameFromLayoutMethodDescriptor = sweeperServiceServiceDescriptor.Methods().ByName("StartGameFromLayout")
+	sweeperServiceGetGameMethodDescriptor             = sweeperServiceServiceDescriptor.Methods().ByName("GetGame")
+	sweeperServiceMakeMoveMethodDescriptor            = sweeperServiceServiceDescriptor.Methods().ByName("MakeMove")
+	sweeperServiceMakeMovesMethodDescriptor           = sweeperServiceServiceDescriptor.Methods().ByName("MakeMoves")
+	sweeperServiceExportGameMethodDescriptor          = sweeperServiceServiceDescriptor.Methods().ByName("ExportGame")
+	sweeperServiceExportReplayMethodDescriptor        = sweeperServiceServiceDescriptor.Methods().ByName("ExportReplay")
+	sweeperServiceInvitePlayerMethodDescriptor        = sweeperServiceServiceDescriptor.Methods().ByName("InvitePlayer")
+	sweeperServiceJoinGameMethodDescriptor            = sweeperServiceServiceDescriptor.Methods().ByName("JoinGame")
+	sweeperServiceSpectateMethodDescriptor            = sweeperServiceServiceDescriptor.Methods().ByName("Spectate")
+	sweeperServiceStartMatchMethodDescriptor          = sweeperServiceServiceDescriptor.Methods().ByName("StartMatch")
+	sweeperServiceGetMatchMethodDescriptor            = sweeperServiceServiceDescriptor.Methods().ByName("GetMatch")
+	sweeperServiceCreateTournamentMethodDescriptor    = sweeperServiceServiceDescriptor.Methods().ByName("CreateTournament")
+	sweeperServiceGetTournamentMethodDescriptor       = sweeperServiceServiceDescriptor.Methods().ByName("GetTournament")
+	sweeperServiceQueueMethodDescriptor               = sweeperServiceServiceDescriptor.Methods().ByName("Queue")
+	sweeperServiceStartEndlessGameMethodDescriptor    = sweeperServiceServiceDescriptor.Methods().ByName("StartEndlessGame")
+	sweeperServiceGetEndlessGameMethodDescriptor      = sweeperServiceServiceDescriptor.Methods().ByName("GetEndlessGame")
+	sweeperServiceMakeEndlessMoveMethodDescriptor     = sweeperServiceServiceDescriptor.Methods().ByName("MakeEndlessMove")
 )
 
 // SweeperServiceClient is a client for the sweeper.v1.SweeperService service.
 type SweeperServiceClient interface {
 	StartGame(context.Context, *connect.Request[v1.StartGameRequest]) (*connect.Response[v1.StartGameResponse], error)
+	StartGameFromLayout(context.Context, *connect.Request[v1.StartGameFromLayoutRequest]) (*connect.Response[v1.StartGameFromLayoutResponse], error)
 	GetGame(context.Context, *connect.Request[v1.GetGameRequest]) (*connect.Response[v1.GetGameResponse], error)
 	MakeMove(context.Context, *connect.Request[v1.MakeMoveRequest]) (*connect.Response[v1.MakeMoveResponse], error)
+	MakeMoves(context.Context, *connect.Request[v1.MakeMovesRequest]) (*connect.Response[v1.MakeMovesResponse], error)
+	ExportGame(context.Context, *connect.Request[v1.ExportGameRequest]) (*connect.Response[v1.ExportGameResponse], error)
+	ExportReplay(context.Context, *connect.Request[v1.ExportReplayRequest]) (*connect.Response[v1.ExportReplayResponse], error)
+	InvitePlayer(context.Context, *connect.Request[v1.InvitePlayerRequest]) (*connect.Response[v1.InvitePlayerResponse], error)
+	JoinGame(context.Context, *connect.Request[v1.JoinGameRequest]) (*connect.Response[v1.JoinGameResponse], error)
+	// Spectate streams the game read-only each time it changes, until it finishes.
+	Spectate(context.Context, *connect.Request[v1.SpectateRequest]) (*connect.ServerStreamForClient[v1.SpectateResponse], error)
+	StartMatch(context.Context, *connect.Request[v1.StartMatchRequest]) (*connect.Response[v1.StartMatchResponse], error)
+	GetMatch(context.Context, *connect.Request[v1.GetMatchRequest]) (*connect.Response[v1.GetMatchResponse], error)
+	CreateTournament(context.Context, *connect.Request[v1.CreateTournamentRequest]) (*connect.Response[v1.CreateTournamentResponse], error)
+	GetTournament(context.Context, *connect.Request[v1.GetTournamentRequest]) (*connect.Response[v1.GetTournamentResponse], error)
+	// Queue waits for enough players to start a game with the preset, then reports where to find it.
+	Queue(context.Context, *connect.Request[v1.QueueRequest]) (*connect.ServerStreamForClient[v1.QueueResponse], error)
+	StartEndlessGame(context.Context, *connect.Request[v1.StartEndlessGameRequest]) (*connect.Response[v1.StartEndlessGameResponse], error)
+	GetEndlessGame(context.Context, *connect.Request[v1.GetEndlessGameRequest]) (*connect.Response[v1.GetEndlessGameResponse], error)
+	MakeEndlessMove(context.Context, *connect.Request[v1.MakeEndlessMoveRequest]) (*connect.Response[v1.MakeEndlessMoveResponse], error)
 }
 
 // NewSweeperServiceClient constructs a client for the sweeper.v1.SweeperService service. By
@@ -73,6 +146,12 @@ func NewSweeperServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(sweeperServiceStartGameMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		startGameFromLayout: connect.NewClient[v1.StartGameFromLayoutRequest, v1.StartGameFromLayoutResponse](
+			httpClient,
+			baseURL+SweeperServiceStartGameFromLayoutProcedure,
+			connect.WithSchema(sweeperServiceStartGameFromLayoutMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getGame: connect.NewClient[v1.GetGameRequest, v1.GetGameResponse](
 			httpClient,
 			baseURL+SweeperServiceGetGameProcedure,
@@ -85,14 +164,113 @@ func NewSweeperServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(sweeperServiceMakeMoveMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		makeMoves: connect.NewClient[v1.MakeMovesRequest, v1.MakeMovesResponse](
+			httpClient,
+			baseURL+SweeperServiceMakeMovesProcedure,
+			connect.WithSchema(sweeperServiceMakeMovesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		exportGame: connect.NewClient[v1.ExportGameRequest, v1.ExportGameResponse](
+			httpClient,
+			baseURL+SweeperServiceExportGameProcedure,
+			connect.WithSchema(sweeperServiceExportGameMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		exportReplay: connect.NewClient[v1.ExportReplayRequest, v1.ExportReplayResponse](
+			httpClient,
+			baseURL+SweeperServiceExportReplayProcedure,
+			connect.WithSchema(sweeperServiceExportReplayMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		invitePlayer: connect.NewClient[v1.InvitePlayerRequest, v1.InvitePlayerResponse](
+			httpClient,
+			baseURL+SweeperServiceInvitePlayerProcedure,
+			connect.WithSchema(sweeperServiceInvitePlayerMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		joinGame: connect.NewClient[v1.JoinGameRequest, v1.JoinGameResponse](
+			httpClient,
+			baseURL+SweeperServiceJoinGameProcedure,
+			connect.WithSchema(sweeperServiceJoinGameMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		spectate: connect.NewClient[v1.SpectateRequest, v1.SpectateResponse](
+			httpClient,
+			baseURL+SweeperServiceSpectateProcedure,
+			connect.WithSchema(sweeperServiceSpectateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		startMatch: connect.NewClient[v1.StartMatchRequest, v1.StartMatchResponse](
+			httpClient,
+			baseURL+SweeperServiceStartMatchProcedure,
+			connect.WithSchema(sweeperServiceStartMatchMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getMatch: connect.NewClient[v1.GetMatchRequest, v1.GetMatchResponse](
+			httpClient,
+			baseURL+SweeperServiceGetMatchProcedure,
+			connect.WithSchema(sweeperServiceGetMatchMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createTournament: connect.NewClient[v1.CreateTournamentRequest, v1.CreateTournamentResponse](
+			httpClient,
+			baseURL+SweeperServiceCreateTournamentProcedure,
+			connect.WithSchema(sweeperServiceCreateTournamentMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getTournament: connect.NewClient[v1.GetTournamentRequest, v1.GetTournamentResponse](
+			httpClient,
+			baseURL+SweeperServiceGetTournamentProcedure,
+			connect.WithSchema(sweeperServiceGetTournamentMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		queue: connect.NewClient[v1.QueueRequest, v1.QueueResponse](
+			httpClient,
+			baseURL+SweeperServiceQueueProcedure,
+			connect.WithSchema(sweeperServiceQueueMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		startEndlessGame: connect.NewClient[v1.StartEndlessGameRequest, v1.StartEndlessGameResponse](
+			httpClient,
+			baseURL+SweeperServiceStartEndlessGameProcedure,
+			connect.WithSchema(sweeperServiceStartEndlessGameMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getEndlessGame: connect.NewClient[v1.GetEndlessGameRequest, v1.GetEndlessGameResponse](
+			httpClient,
+			baseURL+SweeperServiceGetEndlessGameProcedure,
+			connect.WithSchema(sweeperServiceGetEndlessGameMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		makeEndlessMove: connect.NewClient[v1.MakeEndlessMoveRequest, v1.MakeEndlessMoveResponse](
+			httpClient,
+			baseURL+SweeperServiceMakeEndlessMoveProcedure,
+			connect.WithSchema(sweeperServiceMakeEndlessMoveMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // sweeperServiceClient implements SweeperServiceClient.
 type sweeperServiceClient struct {
-	startGame *connect.Client[v1.StartGameRequest, v1.StartGameResponse]
-	getGame   *connect.Client[v1.GetGameRequest, v1.GetGameResponse]
-	makeMove  *connect.Client[v1.MakeMoveRequest, v1.MakeMoveResponse]
+	startGame           *connect.Client[v1.StartGameRequest, v1.StartGameResponse]
+	startGameFromLayout *connect.Client[v1.StartGameFromLayoutRequest, v1.StartGameFromLayoutResponse]
+	getGame             *connect.Client[v1.GetGameRequest, v1.GetGameResponse]
+	makeMove            *connect.Client[v1.MakeMoveRequest, v1.MakeMoveResponse]
+	makeMoves           *connect.Client[v1.MakeMovesRequest, v1.MakeMovesResponse]
+	exportGame          *connect.Client[v1.ExportGameRequest, v1.ExportGameResponse]
+	exportReplay        *connect.Client[v1.ExportReplayRequest, v1.ExportReplayResponse]
+	invitePlayer        *connect.Client[v1.InvitePlayerRequest, v1.InvitePlayerResponse]
+	joinGame            *connect.Client[v1.JoinGameRequest, v1.JoinGameResponse]
+	spectate            *connect.Client[v1.SpectateRequest, v1.SpectateResponse]
+	startMatch          *connect.Client[v1.StartMatchRequest, v1.StartMatchResponse]
+	getMatch            *connect.Client[v1.GetMatchRequest, v1.GetMatchResponse]
+	createTournament    *connect.Client[v1.CreateTournamentRequest, v1.CreateTournamentResponse]
+	getTournament       *connect.Client[v1.GetTournamentRequest, v1.GetTournamentResponse]
+	queue               *connect.Client[v1.QueueRequest, v1.QueueResponse]
+	startEndlessGame    *connect.Client[v1.StartEndlessGameRequest, v1.StartEndlessGameResponse]
+	getEndlessGame      *connect.Client[v1.GetEndlessGameRequest, v1.GetEndlessGameResponse]
+	makeEndlessMove     *connect.Client[v1.MakeEndlessMoveRequest, v1.MakeEndlessMoveResponse]
 }
 
 // StartGame calls sweeper.v1.SweeperService.StartGame.
@@ -100,6 +278,11 @@ func (c *sweeperServiceClient) StartGame(ctx context.Context, req *connect.Reque
 	return c.startGame.CallUnary(ctx, req)
 }
 
+// StartGameFromLayout calls sweeper.v1.SweeperService.StartGameFromLayout.
+func (c *sweeperServiceClient) StartGameFromLayout(ctx context.Context, req *connect.Request[v1.StartGameFromLayoutRequest]) (*connect.Response[v1.StartGameFromLayoutResponse], error) {
+	return c.startGameFromLayout.CallUnary(ctx, req)
+}
+
 // GetGame calls sweeper.v1.SweeperService.GetGame.
 func (c *sweeperServiceClient) GetGame(ctx context.Context, req *connect.Request[v1.GetGameRequest]) (*connect.Response[v1.GetGameResponse], error) {
 	return c.getGame.CallUnary(ctx, req)
@@ -110,11 +293,98 @@ func (c *sweeperServiceClient) MakeMove(ctx context.Context, req *connect.Reques
 	return c.makeMove.CallUnary(ctx, req)
 }
 
+// MakeMoves calls sweeper.v1.SweeperService.MakeMoves.
+func (c *sweeperServiceClient) MakeMoves(ctx context.Context, req *connect.Request[v1.MakeMovesRequest]) (*connect.Response[v1.MakeMovesResponse], error) {
+	return c.makeMoves.CallUnary(ctx, req)
+}
+
+// ExportGame calls sweeper.v1.SweeperService.ExportGame.
+func (c *sweeperServiceClient) ExportGame(ctx context.Context, req *connect.Request[v1.ExportGameRequest]) (*connect.Response[v1.ExportGameResponse], error) {
+	return c.exportGame.CallUnary(ctx, req)
+}
+
+// ExportReplay calls sweeper.v1.SweeperService.ExportReplay.
+func (c *sweeperServiceClient) ExportReplay(ctx context.Context, req *connect.Request[v1.ExportReplayRequest]) (*connect.Response[v1.ExportReplayResponse], error) {
+	return c.exportReplay.CallUnary(ctx, req)
+}
+
+// InvitePlayer calls sweeper.v1.SweeperService.InvitePlayer.
+func (c *sweeperServiceClient) InvitePlayer(ctx context.Context, req *connect.Request[v1.InvitePlayerRequest]) (*connect.Response[v1.InvitePlayerResponse], error) {
+	return c.invitePlayer.CallUnary(ctx, req)
+}
+
+// JoinGame calls sweeper.v1.SweeperService.JoinGame.
+func (c *sweeperServiceClient) JoinGame(ctx context.Context, req *connect.Request[v1.JoinGameRequest]) (*connect.Response[v1.JoinGameResponse], error) {
+	return c.joinGame.CallUnary(ctx, req)
+}
+
+// Spectate calls sweeper.v1.SweeperService.Spectate.
+func (c *sweeperServiceClient) Spectate(ctx context.Context, req *connect.Request[v1.SpectateRequest]) (*connect.ServerStreamForClient[v1.SpectateResponse], error) {
+	return c.spectate.CallServerStream(ctx, req)
+}
+
+// StartMatch calls sweeper.v1.SweeperService.StartMatch.
+func (c *sweeperServiceClient) StartMatch(ctx context.Context, req *connect.Request[v1.StartMatchRequest]) (*connect.Response[v1.StartMatchResponse], error) {
+	return c.startMatch.CallUnary(ctx, req)
+}
+
+// GetMatch calls sweeper.v1.SweeperService.GetMatch.
+func (c *sweeperServiceClient) GetMatch(ctx context.Context, req *connect.Request[v1.GetMatchRequest]) (*connect.Response[v1.GetMatchResponse], error) {
+	return c.getMatch.CallUnary(ctx, req)
+}
+
+// CreateTournament calls sweeper.v1.SweeperService.CreateTournament.
+func (c *sweeperServiceClient) CreateTournament(ctx context.Context, req *connect.Request[v1.CreateTournamentRequest]) (*connect.Response[v1.CreateTournamentResponse], error) {
+	return c.createTournament.CallUnary(ctx, req)
+}
+
+// GetTournament calls sweeper.v1.SweeperService.GetTournament.
+func (c *sweeperServiceClient) GetTournament(ctx context.Context, req *connect.Request[v1.GetTournamentRequest]) (*connect.Response[v1.GetTournamentResponse], error) {
+	return c.getTournament.CallUnary(ctx, req)
+}
+
+// Queue calls sweeper.v1.SweeperService.Queue.
+func (c *sweeperServiceClient) Queue(ctx context.Context, req *connect.Request[v1.QueueRequest]) (*connect.ServerStreamForClient[v1.QueueResponse], error) {
+	return c.queue.CallServerStream(ctx, req)
+}
+
+// StartEndlessGame calls sweeper.v1.SweeperService.StartEndlessGame.
+func (c *sweeperServiceClient) StartEndlessGame(ctx context.Context, req *connect.Request[v1.StartEndlessGameRequest]) (*connect.Response[v1.StartEndlessGameResponse], error) {
+	return c.startEndlessGame.CallUnary(ctx, req)
+}
+
+// GetEndlessGame calls sweeper.v1.SweeperService.GetEndlessGame.
+func (c *sweeperServiceClient) GetEndlessGame(ctx context.Context, req *connect.Request[v1.GetEndlessGameRequest]) (*connect.Response[v1.GetEndlessGameResponse], error) {
+	return c.getEndlessGame.CallUnary(ctx, req)
+}
+
+// MakeEndlessMove calls sweeper.v1.SweeperService.MakeEndlessMove.
+func (c *sweeperServiceClient) MakeEndlessMove(ctx context.Context, req *connect.Request[v1.MakeEndlessMoveRequest]) (*connect.Response[v1.MakeEndlessMoveResponse], error) {
+	return c.makeEndlessMove.CallUnary(ctx, req)
+}
+
 // SweeperServiceHandler is an implementation of the sweeper.v1.SweeperService service.
 type SweeperServiceHandler interface {
 	StartGame(context.Context, *connect.Request[v1.StartGameRequest]) (*connect.Response[v1.StartGameResponse], error)
+	StartGameFromLayout(context.Context, *connect.Request[v1.StartGameFromLayoutRequest]) (*connect.Response[v1.StartGameFromLayoutResponse], error)
 	GetGame(context.Context, *connect.Request[v1.GetGameRequest]) (*connect.Response[v1.GetGameResponse], error)
 	MakeMove(context.Context, *connect.Request[v1.MakeMoveRequest]) (*connect.Response[v1.MakeMoveResponse], error)
+	MakeMoves(context.Context, *connect.Request[v1.MakeMovesRequest]) (*connect.Response[v1.MakeMovesResponse], error)
+	ExportGame(context.Context, *connect.Request[v1.ExportGameRequest]) (*connect.Response[v1.ExportGameResponse], error)
+	ExportReplay(context.Context, *connect.Request[v1.ExportReplayRequest]) (*connect.Response[v1.ExportReplayResponse], error)
+	InvitePlayer(context.Context, *connect.Request[v1.InvitePlayerRequest]) (*connect.Response[v1.InvitePlayerResponse], error)
+	JoinGame(context.Context, *connect.Request[v1.JoinGameRequest]) (*connect.Response[v1.JoinGameResponse], error)
+	// Spectate streams the game read-only each time it changes, until it finishes.
+	Spectate(context.Context, *connect.Request[v1.SpectateRequest], *connect.ServerStream[v1.SpectateResponse]) error
+	StartMatch(context.Context, *connect.Request[v1.StartMatchRequest]) (*connect.Response[v1.StartMatchResponse], error)
+	GetMatch(context.Context, *connect.Request[v1.GetMatchRequest]) (*connect.Response[v1.GetMatchResponse], error)
+	CreateTournament(context.Context, *connect.Request[v1.CreateTournamentRequest]) (*connect.Response[v1.CreateTournamentResponse], error)
+	GetTournament(context.Context, *connect.Request[v1.GetTournamentRequest]) (*connect.Response[v1.GetTournamentResponse], error)
+	// Queue waits for enough players to start a game with the preset, then reports where to find it.
+	Queue(context.Context, *connect.Request[v1.QueueRequest], *connect.ServerStream[v1.QueueResponse]) error
+	StartEndlessGame(context.Context, *connect.Request[v1.StartEndlessGameRequest]) (*connect.Response[v1.StartEndlessGameResponse], error)
+	GetEndlessGame(context.Context, *connect.Request[v1.GetEndlessGameRequest]) (*connect.Response[v1.GetEndlessGameResponse], error)
+	MakeEndlessMove(context.Context, *connect.Request[v1.MakeEndlessMoveRequest]) (*connect.Response[v1.MakeEndlessMoveResponse], error)
 }
 
 // NewSweeperServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -129,6 +399,12 @@ func NewSweeperServiceHandler(svc SweeperServiceHandler, opts ...connect.Handler
 		connect.WithSchema(sweeperServiceStartGameMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	sweeperServiceStartGameFromLayoutHandler := connect.NewUnaryHandler(
+		SweeperServiceStartGameFromLayoutProcedure,
+		svc.StartGameFromLayout,
+		connect.WithSchema(sweeperServiceStartGameFromLayoutMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	sweeperServiceGetGameHandler := connect.NewUnaryHandler(
 		SweeperServiceGetGameProcedure,
 		svc.GetGame,
@@ -141,14 +417,128 @@ func NewSweeperServiceHandler(svc SweeperServiceHandler, opts ...connect.Handler
 		connect.WithSchema(sweeperServiceMakeMoveMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	sweeperServiceMakeMovesHandler := connect.NewUnaryHandler(
+		SweeperServiceMakeMovesProcedure,
+		svc.MakeMoves,
+		connect.WithSchema(sweeperServiceMakeMovesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sweeperServiceExportGameHandler := connect.NewUnaryHandler(
+		SweeperServiceExportGameProcedure,
+		svc.ExportGame,
+		connect.WithSchema(sweeperServiceExportGameMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sweeperServiceExportReplayHandler := connect.NewUnaryHandler(
+		SweeperServiceExportReplayProcedure,
+		svc.ExportReplay,
+		connect.WithSchema(sweeperServiceExportReplayMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sweeperServiceInvitePlayerHandler := connect.NewUnaryHandler(
+		SweeperServiceInvitePlayerProcedure,
+		svc.InvitePlayer,
+		connect.WithSchema(sweeperServiceInvitePlayerMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sweeperServiceJoinGameHandler := connect.NewUnaryHandler(
+		SweeperServiceJoinGameProcedure,
+		svc.JoinGame,
+		connect.WithSchema(sweeperServiceJoinGameMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sweeperServiceSpectateHandler := connect.NewServerStreamHandler(
+		SweeperServiceSpectateProcedure,
+		svc.Spectate,
+		connect.WithSchema(sweeperServiceSpectateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sweeperServiceStartMatchHandler := connect.NewUnaryHandler(
+		SweeperServiceStartMatchProcedure,
+		svc.StartMatch,
+		connect.WithSchema(sweeperServiceStartMatchMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sweeperServiceGetMatchHandler := connect.NewUnaryHandler(
+		SweeperServiceGetMatchProcedure,
+		svc.GetMatch,
+		connect.WithSchema(sweeperServiceGetMatchMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sweeperServiceCreateTournamentHandler := connect.NewUnaryHandler(
+		SweeperServiceCreateTournamentProcedure,
+		svc.CreateTournament,
+		connect.WithSchema(sweeperServiceCreateTournamentMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sweeperServiceGetTournamentHandler := connect.NewUnaryHandler(
+		SweeperServiceGetTournamentProcedure,
+		svc.GetTournament,
+		connect.WithSchema(sweeperServiceGetTournamentMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sweeperServiceQueueHandler := connect.NewServerStreamHandler(
+		SweeperServiceQueueProcedure,
+		svc.Queue,
+		connect.WithSchema(sweeperServiceQueueMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sweeperServiceStartEndlessGameHandler := connect.NewUnaryHandler(
+		SweeperServiceStartEndlessGameProcedure,
+		svc.StartEndlessGame,
+		connect.WithSchema(sweeperServiceStartEndlessGameMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sweeperServiceGetEndlessGameHandler := connect.NewUnaryHandler(
+		SweeperServiceGetEndlessGameProcedure,
+		svc.GetEndlessGame,
+		connect.WithSchema(sweeperServiceGetEndlessGameMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	sweeperServiceMakeEndlessMoveHandler := connect.NewUnaryHandler(
+		SweeperServiceMakeEndlessMoveProcedure,
+		svc.MakeEndlessMove,
+		connect.WithSchema(sweeperServiceMakeEndlessMoveMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/sweeper.v1.SweeperService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SweeperServiceStartGameProcedure:
 			sweeperServiceStartGameHandler.ServeHTTP(w, r)
+		case SweeperServiceStartGameFromLayoutProcedure:
+			sweeperServiceStartGameFromLayoutHandler.ServeHTTP(w, r)
 		case SweeperServiceGetGameProcedure:
 			sweeperServiceGetGameHandler.ServeHTTP(w, r)
 		case SweeperServiceMakeMoveProcedure:
 			sweeperServiceMakeMoveHandler.ServeHTTP(w, r)
+		case SweeperServiceMakeMovesProcedure:
+			sweeperServiceMakeMovesHandler.ServeHTTP(w, r)
+		case SweeperServiceExportGameProcedure:
+			sweeperServiceExportGameHandler.ServeHTTP(w, r)
+		case SweeperServiceExportReplayProcedure:
+			sweeperServiceExportReplayHandler.ServeHTTP(w, r)
+		case SweeperServiceInvitePlayerProcedure:
+			sweeperServiceInvitePlayerHandler.ServeHTTP(w, r)
+		case SweeperServiceJoinGameProcedure:
+			sweeperServiceJoinGameHandler.ServeHTTP(w, r)
+		case SweeperServiceSpectateProcedure:
+			sweeperServiceSpectateHandler.ServeHTTP(w, r)
+		case SweeperServiceStartMatchProcedure:
+			sweeperServiceStartMatchHandler.ServeHTTP(w, r)
+		case SweeperServiceGetMatchProcedure:
+			sweeperServiceGetMatchHandler.ServeHTTP(w, r)
+		case SweeperServiceCreateTournamentProcedure:
+			sweeperServiceCreateTournamentHandler.ServeHTTP(w, r)
+		case SweeperServiceGetTournamentProcedure:
+			sweeperServiceGetTournamentHandler.ServeHTTP(w, r)
+		case SweeperServiceQueueProcedure:
+			sweeperServiceQueueHandler.ServeHTTP(w, r)
+		case SweeperServiceStartEndlessGameProcedure:
+			sweeperServiceStartEndlessGameHandler.ServeHTTP(w, r)
+		case SweeperServiceGetEndlessGameProcedure:
+			sweeperServiceGetEndlessGameHandler.ServeHTTP(w, r)
+		case SweeperServiceMakeEndlessMoveProcedure:
+			sweeperServiceMakeEndlessMoveHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -162,6 +552,10 @@ func (UnimplementedSweeperServiceHandler) StartGame(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.StartGame is not implemented"))
 }
 
+func (UnimplementedSweeperServiceHandler) StartGameFromLayout(context.Context, *connect.Request[v1.StartGameFromLayoutRequest]) (*connect.Response[v1.StartGameFromLayoutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.StartGameFromLayout is not implemented"))
+}
+
 func (UnimplementedSweeperServiceHandler) GetGame(context.Context, *connect.Request[v1.GetGameRequest]) (*connect.Response[v1.GetGameResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.GetGame is not implemented"))
 }
@@ -169,3 +563,59 @@ func (UnimplementedSweeperServiceHandler) GetGame(context.Context, *connect.Requ
 func (UnimplementedSweeperServiceHandler) MakeMove(context.Context, *connect.Request[v1.MakeMoveRequest]) (*connect.Response[v1.MakeMoveResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.MakeMove is not implemented"))
 }
+
+func (UnimplementedSweeperServiceHandler) MakeMoves(context.Context, *connect.Request[v1.MakeMovesRequest]) (*connect.Response[v1.MakeMovesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.MakeMoves is not implemented"))
+}
+
+func (UnimplementedSweeperServiceHandler) ExportGame(context.Context, *connect.Request[v1.ExportGameRequest]) (*connect.Response[v1.ExportGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.ExportGame is not implemented"))
+}
+
+func (UnimplementedSweeperServiceHandler) ExportReplay(context.Context, *connect.Request[v1.ExportReplayRequest]) (*connect.Response[v1.ExportReplayResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.ExportReplay is not implemented"))
+}
+
+func (UnimplementedSweeperServiceHandler) InvitePlayer(context.Context, *connect.Request[v1.InvitePlayerRequest]) (*connect.Response[v1.InvitePlayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.InvitePlayer is not implemented"))
+}
+
+func (UnimplementedSweeperServiceHandler) JoinGame(context.Context, *connect.Request[v1.JoinGameRequest]) (*connect.Response[v1.JoinGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.JoinGame is not implemented"))
+}
+
+func (UnimplementedSweeperServiceHandler) Spectate(context.Context, *connect.Request[v1.SpectateRequest], *connect.ServerStream[v1.SpectateResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.Spectate is not implemented"))
+}
+
+func (UnimplementedSweeperServiceHandler) StartMatch(context.Context, *connect.Request[v1.StartMatchRequest]) (*connect.Response[v1.StartMatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.StartMatch is not implemented"))
+}
+
+func (UnimplementedSweeperServiceHandler) GetMatch(context.Context, *connect.Request[v1.GetMatchRequest]) (*connect.Response[v1.GetMatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.GetMatch is not implemented"))
+}
+
+func (UnimplementedSweeperServiceHandler) CreateTournament(context.Context, *connect.Request[v1.CreateTournamentRequest]) (*connect.Response[v1.CreateTournamentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.CreateTournament is not implemented"))
+}
+
+func (UnimplementedSweeperServiceHandler) GetTournament(context.Context, *connect.Request[v1.GetTournamentRequest]) (*connect.Response[v1.GetTournamentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.GetTournament is not implemented"))
+}
+
+func (UnimplementedSweeperServiceHandler) Queue(context.Context, *connect.Request[v1.QueueRequest], *connect.ServerStream[v1.QueueResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.Queue is not implemented"))
+}
+
+func (UnimplementedSweeperServiceHandler) StartEndlessGame(context.Context, *connect.Request[v1.StartEndlessGameRequest]) (*connect.Response[v1.StartEndlessGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.StartEndlessGame is not implemented"))
+}
+
+func (UnimplementedSweeperServiceHandler) GetEndlessGame(context.Context, *connect.Request[v1.GetEndlessGameRequest]) (*connect.Response[v1.GetEndlessGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.GetEndlessGame is not implemented"))
+}
+
+func (UnimplementedSweeperServiceHandler) MakeEndlessMove(context.Context, *connect.Request[v1.MakeEndlessMoveRequest]) (*connect.Response[v1.MakeEndlessMoveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sweeper.v1.SweeperService.MakeEndlessMove is not implemented"))
+}
@@ -1,53 +1,315 @@
 package sweeperv1
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/nightmarlin/sweeper"
 )
 
-func InternalGameToGame(g *sweeper.Game) *Game {
-	cells := make([]*Cell, 0, len(g.Cells))
-	for ref, cell := range g.Cells {
-		c := &Cell{Row: int32(ref.Row), Column: int32(ref.Column), State: nil}
-
-		switch cell.State {
-		case sweeper.CellDefault:
-			c.State = &Cell_Unrevealed{Unrevealed: &emptypb.Empty{}}
-		case sweeper.CellFlagged:
-			c.State = &Cell_Flagged{Flagged: &emptypb.Empty{}}
-		case sweeper.CellQuestioned:
-			c.State = &Cell_Questioned{Questioned: &emptypb.Empty{}}
-		case sweeper.CellRevealed:
-			rc := &RevealedCell{}
-			if cell.ContainsMine {
-				rc.Value = &RevealedCell_Mine{Mine: &emptypb.Empty{}}
-			} else {
-				rc.Value = &RevealedCell_Clear{
-					Clear: &ClearRevealedCell{NeighbouringMines: int32(cell.NeighbouringMines)},
-				}
+// InternalGameToGame converts a sweeper.Game to a Game, using now to calculate
+// how much time the player has remaining.
+func InternalGameToGame(g *sweeper.Game, now time.Time) *Game {
+	// the Cells are allocated together, as large Boards have millions of them.
+	var (
+		cells   = make([]*Cell, 0, g.Cells.Len())
+		backing = make([]Cell, g.Cells.Len())
+	)
+	for i := range g.Cells.Len() {
+		c := &backing[i]
+		setCell(c, g.Cells.Ref(i), g.Cells.Nth(i))
+		cells = append(cells, c)
+	}
+	return internalGameToGame(g, now, cells)
+}
+
+// InternalGameRegionToGame converts a sweeper.Game to a Game as
+// InternalGameToGame does, holding only the Cells in the Region of each layer.
+func InternalGameRegionToGame(g *sweeper.Game, now time.Time, r sweeper.Region) *Game {
+	r = r.Within(g.Board)
+
+	var (
+		layers  = max(g.Board.Depth, 1)
+		cells   = make([]*Cell, 0, layers*r.Len())
+		backing = make([]Cell, layers*r.Len())
+	)
+	for layer := range layers {
+		for i := range r.Len() {
+			ref := r.Ref(i)
+			ref.Layer = layer
+
+			c := &backing[len(cells)]
+			setCell(c, ref, g.Cells.At(ref))
+			cells = append(cells, c)
+		}
+	}
+
+	res := internalGameToGame(g, now, cells)
+	res.Region = InternalRegionToRegion(r)
+	return res
+}
+
+// internalGameToGame converts everything but the Cells of a sweeper.Game.
+func internalGameToGame(g *sweeper.Game, now time.Time, cells []*Cell) *Game {
+	progress := g.Progress()
+	res := &Game{
+		Id:        g.ID.String(),
+		State:     internalGameStateToGameState(g.State),
+		Board:     InternalBoardToBoard(g.Board),
+		Cells:     cells,
+		StartedAt: timestamppb.New(g.StartedAt),
+		Summary: &GameSummary{
+			FlagsPlaced:    int32(progress.FlagsPlaced),
+			CellsRevealed:  int32(progress.CellsRevealed),
+			MinesRemaining: int32(g.Board.Mines - progress.FlagsPlaced),
+		},
+	}
+	if remaining, ok := g.Remaining(now); ok {
+		res.TimeRemaining = durationpb.New(remaining)
+	}
+	for _, p := range g.Players {
+		res.Players = append(res.Players, &Player{
+			Id:          string(p.ID),
+			CellsOpened: int32(p.CellsOpened),
+			Score:       int32(p.Score),
+		})
+	}
+	if g.Board.Rules == sweeper.RulesFlags {
+		if g.State == sweeper.GameOngoing && g.Turn < len(g.Players) {
+			res.TurnPlayerId = string(g.Players[g.Turn].ID)
+		}
+		res.WinnerId = string(g.Winner)
+	}
+	for _, id := range g.Invited {
+		res.InvitedPlayerIds = append(res.InvitedPlayerIds, string(id))
+	}
+	if g.MatchID != uuid.Nil {
+		res.MatchId = g.MatchID.String()
+	}
+
+	return res
+}
+
+// setCell fills in c from the sweeper.Cell at ref.
+func setCell(c *Cell, ref sweeper.CellRef, cell sweeper.Cell) {
+	c.Layer, c.Row, c.Column = int32(ref.Layer), int32(ref.Row), int32(ref.Column)
+
+	switch cell.State {
+	case sweeper.CellDefault:
+		c.State = &Cell_Unrevealed{Unrevealed: &emptypb.Empty{}}
+	case sweeper.CellFlagged:
+		c.State = &Cell_Flagged{Flagged: &emptypb.Empty{}}
+		c.Flags = int32(cell.Flags)
+	case sweeper.CellQuestioned:
+		c.State = &Cell_Questioned{Questioned: &emptypb.Empty{}}
+	case sweeper.CellRevealed:
+		rc := &RevealedCell{}
+		if cell.ContainsMine() {
+			rc.Value = &RevealedCell_Mine{Mine: &emptypb.Empty{}}
+		} else {
+			rc.Value = &RevealedCell_Clear{
+				Clear: &ClearRevealedCell{NeighbouringMines: int32(cell.NeighbouringMines)},
 			}
+		}
 
-			c.State = &Cell_Revealed{Revealed: rc}
+		c.State = &Cell_Revealed{Revealed: rc}
+	}
+}
+
+// GameToInternalGame converts a Game to a sweeper.Game as its players see it:
+// its unrevealed Cells hold nothing, and each revealed mine holds a single
+// mine. Only the Game's Board, Cells, state and Players are converted.
+func GameToInternalGame(g *Game) (*sweeper.Game, error) {
+	id, err := uuid.Parse(g.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("parsing game id: %w", err)
+	}
+
+	res := &sweeper.Game{
+		ID:    id,
+		State: gameStateToInternalGameState(g.GetState()),
+		Board: BoardToInternalBoard(g.GetBoard()),
+		Cells: sweeper.NewCells(BoardToInternalBoard(g.GetBoard())),
+	}
+	for _, c := range g.GetCells() {
+		var cell sweeper.Cell
+		switch s := c.State.(type) {
+		case *Cell_Flagged:
+			cell.State, cell.Flags = sweeper.CellFlagged, max(int(c.Flags), 1)
+		case *Cell_Questioned:
+			cell.State = sweeper.CellQuestioned
+		case *Cell_Revealed:
+			cell.State = sweeper.CellRevealed
+			if clear := s.Revealed.GetClear(); clear != nil {
+				cell.NeighbouringMines = int(clear.NeighbouringMines)
+			} else {
+				cell.Mines = 1
+			}
+		}
+		res.Cells.Set(sweeper.CellRef{Layer: int(c.Layer), Row: int(c.Row), Column: int(c.Column)}, cell)
+	}
+	for i, p := range g.GetPlayers() {
+		res.Players = append(res.Players, sweeper.Player{
+			ID:          sweeper.PlayerID(p.Id),
+			CellsOpened: int(p.CellsOpened),
+			Score:       int(p.Score),
+		})
+		if p.Id == g.GetTurnPlayerId() {
+			res.Turn = i
 		}
+	}
+	res.Winner = sweeper.PlayerID(g.GetWinnerId())
+	return res, nil
+}
+
+// InternalEndlessToEndlessGame converts a sweeper.Endless to an EndlessGame,
+// holding the Cells in the Region as its player sees them.
+func InternalEndlessToEndlessGame(e *sweeper.Endless, r sweeper.Region) (*EndlessGame, error) {
+	view, err := e.View(r)
+	if err != nil {
+		return nil, err
+	}
 
+	var (
+		cells   = make([]*Cell, 0, len(view))
+		backing = make([]Cell, len(view))
+	)
+	for i, cell := range view {
+		c := &backing[i]
+		setCell(c, r.Ref(i), cell)
 		cells = append(cells, c)
 	}
 
-	res := &Game{
-		Id:    g.ID.String(),
-		State: internalGameStateToGameState(g.State),
-		Board: &Board{
-			Height: int32(g.Board.Height),
-			Width:  int32(g.Board.Width),
-			Mines:  int32(g.Board.Mines),
-		},
-		Cells: cells,
+	return &EndlessGame{
+		Id:            e.ID.String(),
+		State:         internalGameStateToGameState(e.State),
+		MinesPerChunk: int32(e.MinesPerChunk),
+		Cleared:       int32(e.Cleared),
+		Region:        InternalRegionToRegion(r),
+		Cells:         cells,
+		PlayerId:      string(e.Player),
+		StartedAt:     timestamppb.New(e.StartedAt),
+	}, nil
+}
+
+// InternalMatchToMatch converts a sweeper.Match to a Match, given the Progress
+// of each of its players.
+func InternalMatchToMatch(m *sweeper.Match, progress []sweeper.Progress) *Match {
+	res := &Match{
+		Id:       m.ID.String(),
+		Board:    InternalBoardToBoard(m.Board),
+		WinnerId: string(m.Winner),
+		Finished: m.Finished(),
+	}
+	for _, p := range progress {
+		res.Players = append(res.Players, &MatchProgress{
+			PlayerId:      string(p.Player),
+			GameId:        p.GameID.String(),
+			State:         internalGameStateToGameState(p.State),
+			CellsRevealed: int32(p.CellsRevealed),
+			FlagsPlaced:   int32(p.FlagsPlaced),
+		})
 	}
+	return res
+}
 
+func InternalBoardToBoard(b sweeper.Board) *Board {
+	res := &Board{
+		Height:          int32(b.Height),
+		Width:           int32(b.Width),
+		Mines:           int32(b.Mines),
+		Topology:        internalTopologyToTopology(b.Topology),
+		Wrap:            b.Wrap,
+		MaxMinesPerCell: int32(b.MaxMinesPerCell),
+		Depth:           int32(b.Depth),
+		Rules:           internalRulesToRules(b.Rules),
+	}
+	if b.TimeLimit > 0 {
+		res.TimeLimit = durationpb.New(b.TimeLimit)
+	}
 	return res
 }
 
+func BoardToInternalBoard(b *Board) sweeper.Board {
+	return sweeper.Board{
+		Width:           int(b.GetWidth()),
+		Height:          int(b.GetHeight()),
+		Mines:           int(b.GetMines()),
+		TimeLimit:       b.GetTimeLimit().AsDuration(),
+		Topology:        topologyToInternalTopology(b.GetTopology()),
+		Wrap:            b.GetWrap(),
+		MaxMinesPerCell: int(b.GetMaxMinesPerCell()),
+		Depth:           int(b.GetDepth()),
+		Rules:           rulesToInternalRules(b.GetRules()),
+	}
+}
+
+func internalRulesToRules(r sweeper.Rules) Rules {
+	switch r {
+	case sweeper.RulesClassic:
+		return Rules_CLASSIC
+	case sweeper.RulesFlags:
+		return Rules_MINE_FLAGS
+	default:
+		return Rules_RULES_UNKNOWN
+	}
+}
+
+func rulesToInternalRules(r Rules) sweeper.Rules {
+	switch r {
+	case Rules_MINE_FLAGS:
+		return sweeper.RulesFlags
+	default:
+		return sweeper.RulesClassic
+	}
+}
+
+func internalTopologyToTopology(t sweeper.Topology) Topology {
+	switch t {
+	case sweeper.TopologySquare:
+		return Topology_SQUARE
+	case sweeper.TopologyHex:
+		return Topology_HEX
+	case sweeper.TopologyTriangle:
+		return Topology_TRIANGLE
+	default:
+		return Topology_TOPOLOGY_UNKNOWN
+	}
+}
+
+func topologyToInternalTopology(t Topology) sweeper.Topology {
+	switch t {
+	case Topology_HEX:
+		return sweeper.TopologyHex
+	case Topology_TRIANGLE:
+		return sweeper.TopologyTriangle
+	default:
+		return sweeper.TopologySquare
+	}
+}
+
+func gameStateToInternalGameState(s GameState) sweeper.GameState {
+	switch s {
+	case GameState_WON:
+		return sweeper.GameWon
+	case GameState_LOST:
+		return sweeper.GameLost
+	case GameState_RESIGNED:
+		return sweeper.GameResigned
+	case GameState_TIMED_OUT:
+		return sweeper.GameTimedOut
+	case GameState_DRAWN:
+		return sweeper.GameDrawn
+	default:
+		return sweeper.GameOngoing
+	}
+}
+
 func internalGameStateToGameState(s sweeper.GameState) GameState {
 	switch s {
 	case sweeper.GameOngoing:
@@ -58,11 +320,92 @@ func internalGameStateToGameState(s sweeper.GameState) GameState {
 		return GameState_LOST
 	case sweeper.GameResigned:
 		return GameState_RESIGNED
+	case sweeper.GameTimedOut:
+		return GameState_TIMED_OUT
+	case sweeper.GameDrawn:
+		return GameState_DRAWN
 	default:
 		return GameState_GAME_STATE_UNKNOWN
 	}
 }
 
+func InternalRegionToRegion(r sweeper.Region) *Region {
+	return &Region{
+		Top:     int32(r.Top),
+		Left:    int32(r.Left),
+		Rows:    int32(r.Rows),
+		Columns: int32(r.Columns),
+	}
+}
+
+// RegionToInternalRegion converts a Region to a sweeper.Region, returning def
+// if it is unset.
+func RegionToInternalRegion(r *Region, def sweeper.Region) sweeper.Region {
+	if r == nil {
+		return def
+	}
+	return sweeper.Region{
+		Top:     int(r.Top),
+		Left:    int(r.Left),
+		Rows:    int(r.Rows),
+		Columns: int(r.Columns),
+	}
+}
+
+func CellMoveToInternalCellRef(m *CellMove) sweeper.CellRef {
+	return sweeper.CellRef{
+		Layer:  int(m.GetLayer()),
+		Row:    int(m.GetRow()),
+		Column: int(m.GetColumn()),
+	}
+}
+
+func CellMoveToInternalCellMove(m *CellMove) sweeper.CellMove {
+	return sweeper.CellMove{
+		Ref:   CellMoveToInternalCellRef(m),
+		State: CellMoveActionToInternalCellState(m.GetAction()),
+		Chord: m.GetAction() == CellMoveAction_CHORD,
+	}
+}
+
+func CellRefToInternalCellRef(r *CellRef) sweeper.CellRef {
+	return sweeper.CellRef{
+		Layer:  int(r.GetLayer()),
+		Row:    int(r.GetRow()),
+		Column: int(r.GetColumn()),
+	}
+}
+
+// LayoutToInternalLayout converts the layout of the request, returning the
+// Board to play it on.
+func LayoutToInternalLayout(req *StartGameFromLayoutRequest) (sweeper.Board, sweeper.Layout, error) {
+	board := BoardToInternalBoard(req.Board)
+
+	switch l := req.Layout.(type) {
+	case *StartGameFromLayoutRequest_Grid:
+		b, layout, err := sweeper.ParseLayout(l.Grid)
+		if err != nil {
+			return sweeper.Board{}, sweeper.Layout{}, err
+		}
+		board.Width, board.Height, board.Depth = b.Width, b.Height, b.Depth
+		return board, layout, nil
+
+	case *StartGameFromLayoutRequest_Mines:
+		var layout sweeper.Layout
+		for _, m := range l.Mines.GetMines() {
+			layout.Mines = append(layout.Mines, CellRefToInternalCellRef(m))
+		}
+		if req.Reveal != nil {
+			ref := CellRefToInternalCellRef(req.Reveal)
+			layout.Reveal = &ref
+		}
+		return board, layout, nil
+
+	default:
+		return sweeper.Board{}, sweeper.Layout{}, fmt.Errorf("%w: no layout given", sweeper.ErrInvalidLayout)
+	}
+}
+
 func CellMoveActionToInternalCellState(m CellMoveAction) sweeper.CellState {
 	switch m {
 	case CellMoveAction_FLAG:
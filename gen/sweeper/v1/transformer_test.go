@@ -1 +1,29 @@
 package sweeperv1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+)
+
+func BenchmarkInternalGameToGame(b *testing.B) {
+	g, err := sweeper.NewGame(
+		context.Background(),
+		uuid.New,
+		sweeper.SeededNumberGenerator(1),
+		sweeper.Board{Width: 1000, Height: 1000, Mines: 150_000},
+	)
+	if err != nil {
+		b.Fatalf("creating game: %v", err)
+	}
+	now := time.Now()
+
+	b.ResetTimer()
+	for range b.N {
+		InternalGameToGame(g, now)
+	}
+}
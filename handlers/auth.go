@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/nightmarlin/sweeper"
+)
+
+// Auth is a connect.Interceptor that authenticates callers by the bearer token
+// in their Authorization header, recording who they are with
+// sweeper.WithCaller. Requests naming any other player than the caller, as
+// their player_id or organiser_id, are refused, so players can't move in or
+// see each other's games.
+type Auth struct {
+	tokens map[string]sweeper.PlayerID
+}
+
+// NewAuth creates an Auth that authenticates each token as its player.
+func NewAuth(tokens map[string]sweeper.PlayerID) Auth {
+	return Auth{tokens: tokens}
+}
+
+func (a Auth) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		player, err := a.authenticate(req.Header())
+		if err != nil {
+			return nil, err
+		}
+		if err := actingAs(req.Any(), player); err != nil {
+			return nil, err
+		}
+		return next(sweeper.WithCaller(ctx, player), req)
+	}
+}
+
+func (a Auth) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (a Auth) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		player, err := a.authenticate(conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(sweeper.WithCaller(ctx, player), authConn{StreamingHandlerConn: conn, player: player})
+	}
+}
+
+// authenticate returns the player whose token is in the header.
+func (a Auth) authenticate(h http.Header) (sweeper.PlayerID, error) {
+	token, ok := strings.CutPrefix(h.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return "", connect.NewError(connect.CodeUnauthenticated, errMissingToken)
+	}
+
+	// every token is compared, so how long it takes doesn't give any away.
+	var player sweeper.PlayerID
+	for t, p := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			player = p
+		}
+	}
+	if player == "" {
+		return "", connect.NewError(connect.CodeUnauthenticated, errInvalidToken)
+	}
+	return player, nil
+}
+
+// authConn checks each message received on a stream only names its caller.
+type authConn struct {
+	connect.StreamingHandlerConn
+	player sweeper.PlayerID
+}
+
+func (c authConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return actingAs(msg, c.player)
+}
+
+// actingAs checks the players the request acts as, if it names any, are the
+// caller.
+func actingAs(msg any, caller sweeper.PlayerID) error {
+	var ids []string
+	if m, ok := msg.(interface{ GetPlayerId() string }); ok {
+		ids = append(ids, m.GetPlayerId())
+	}
+	if m, ok := msg.(interface{ GetOrganiserId() string }); ok {
+		ids = append(ids, m.GetOrganiserId())
+	}
+
+	for _, id := range ids {
+		if id != "" && sweeper.PlayerID(id) != caller {
+			return connect.NewError(
+				connect.CodePermissionDenied,
+				fmt.Errorf("%w: authenticated as %s, not %s", sweeper.ErrNotAPlayer, caller, id),
+			)
+		}
+	}
+	return nil
+}
+
+var (
+	errMissingToken = fmt.Errorf("missing bearer token")
+	errInvalidToken = fmt.Errorf("invalid bearer token")
+)
@@ -0,0 +1,147 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+	sweeperv1 "github.com/nightmarlin/sweeper/gen/sweeper/v1"
+	"github.com/nightmarlin/sweeper/gen/sweeper/v1/sweeperv1connect"
+	"github.com/nightmarlin/sweeper/handlers"
+	"github.com/nightmarlin/sweeper/infra/memory"
+	"github.com/nightmarlin/sweeper/internal/fakeclock"
+	"github.com/nightmarlin/sweeper/lobby"
+	"github.com/nightmarlin/sweeper/tournament"
+)
+
+var board = &sweeperv1.Board{Height: 8, Width: 8, Mines: 10}
+
+// newAuthServer serves the Connect handler, authenticating each player by the
+// token with the same name.
+func newAuthServer(t *testing.T) sweeperv1connect.SweeperServiceClient {
+	t.Helper()
+
+	var (
+		clock = fakeclock.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		store = memory.NewStore()
+		svc   = sweeper.NewService(store, uuid.New, sweeper.SeededNumberGenerator(1), clock)
+		auth  = handlers.NewAuth(map[string]sweeper.PlayerID{"alice": "alice", "bob": "bob", "eve": "eve"})
+	)
+	mux := http.NewServeMux()
+	mux.Handle(sweeperv1connect.NewSweeperServiceHandler(
+		handlers.NewConnect(svc, lobby.New(svc, clock, time.Minute), tournament.New(svc, store, uuid.New, slog.New(slog.NewTextHandler(io.Discard, nil))), time.Minute),
+		connect.WithInterceptors(auth),
+	))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return sweeperv1connect.NewSweeperServiceClient(srv.Client(), srv.URL)
+}
+
+// as returns a request for the message, authenticated with the token.
+func as[T any](token string, msg *T) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	if token != "" {
+		req.Header().Set("Authorization", "Bearer "+token)
+	}
+	return req
+}
+
+func wantCode(t *testing.T, what string, err error, code connect.Code) {
+	t.Helper()
+	if connect.CodeOf(err) != code {
+		t.Errorf("%s: want code %v, got %v", what, code, err)
+	}
+}
+
+func TestAuth(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		c   = newAuthServer(t)
+	)
+
+	_, err := c.StartGame(ctx, as("", &sweeperv1.StartGameRequest{Board: board, PlayerId: "alice"}))
+	wantCode(t, "starting game without a token", err, connect.CodeUnauthenticated)
+	_, err = c.StartGame(ctx, as("mallory", &sweeperv1.StartGameRequest{Board: board, PlayerId: "alice"}))
+	wantCode(t, "starting game with an unknown token", err, connect.CodeUnauthenticated)
+	_, err = c.StartGame(ctx, as("bob", &sweeperv1.StartGameRequest{Board: board, PlayerId: "alice"}))
+	wantCode(t, "starting game as another player", err, connect.CodePermissionDenied)
+
+	res, err := c.StartGame(ctx, as("alice", &sweeperv1.StartGameRequest{Board: board, PlayerId: "alice"}))
+	if err != nil {
+		t.Fatalf("starting game: %v", err)
+	}
+	id := res.Msg.Game.Id
+
+	// the caller is who views the game, even if they don't say who they are.
+	if _, err := c.GetGame(ctx, as("alice", &sweeperv1.GetGameRequest{GameId: id})); err != nil {
+		t.Errorf("viewing own game: %v", err)
+	}
+	_, err = c.GetGame(ctx, as("bob", &sweeperv1.GetGameRequest{GameId: id}))
+	wantCode(t, "viewing another player's game", err, connect.CodePermissionDenied)
+
+	_, err = c.MakeMove(ctx, as("bob", &sweeperv1.MakeMoveRequest{
+		GameId:   id,
+		PlayerId: "alice",
+		Move:     &sweeperv1.MakeMoveRequest_Cell{Cell: &sweeperv1.CellMove{Action: sweeperv1.CellMoveAction_FLAG}},
+	}))
+	wantCode(t, "moving as another player", err, connect.CodePermissionDenied)
+
+	// streams check the requests they receive too.
+	stream, err := c.Queue(ctx, as("bob", &sweeperv1.QueueRequest{
+		PlayerId: "alice",
+		Preset:   &sweeperv1.Preset{Board: board, Mode: sweeperv1.LobbyMode_VERSUS, Players: 2},
+	}))
+	if err == nil {
+		stream.Receive()
+		err = stream.Err()
+	}
+	wantCode(t, "queueing as another player", err, connect.CodePermissionDenied)
+}
+
+func TestAuth_spectators(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		c   = newAuthServer(t)
+	)
+
+	res, err := c.StartMatch(ctx, as("alice", &sweeperv1.StartMatchRequest{Board: board, PlayerIds: []string{"alice", "bob"}}))
+	if err != nil {
+		t.Fatalf("starting match: %v", err)
+	}
+	var (
+		m     = res.Msg.Match
+		alice = m.Players[0].GameId
+	)
+
+	// spectators only see the delayed stream, even claiming to be a player.
+	for _, player := range []string{"", "alice"} {
+		_, err := c.GetGame(ctx, as("eve", &sweeperv1.GetGameRequest{GameId: alice, PlayerId: player}))
+		wantCode(t, fmt.Sprintf("getting game as %q", player), err, connect.CodePermissionDenied)
+		_, err = c.ExportGame(ctx, as("eve", &sweeperv1.ExportGameRequest{GameId: alice, PlayerId: player}))
+		wantCode(t, fmt.Sprintf("exporting game as %q", player), err, connect.CodePermissionDenied)
+		_, err = c.GetMatch(ctx, as("eve", &sweeperv1.GetMatchRequest{MatchId: m.Id, PlayerId: player}))
+		wantCode(t, fmt.Sprintf("getting match as %q", player), err, connect.CodePermissionDenied)
+	}
+
+	// the opponent can't either.
+	_, err = c.GetGame(ctx, as("bob", &sweeperv1.GetGameRequest{GameId: alice}))
+	wantCode(t, "getting opponent's game", err, connect.CodePermissionDenied)
+
+	if _, err := c.GetGame(ctx, as("alice", &sweeperv1.GetGameRequest{GameId: alice})); err != nil {
+		t.Errorf("getting own game: %v", err)
+	}
+}
@@ -4,23 +4,46 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 
 	"github.com/nightmarlin/sweeper"
+	"github.com/nightmarlin/sweeper/boardtext"
 	sweeperv1 "github.com/nightmarlin/sweeper/gen/sweeper/v1"
 	"github.com/nightmarlin/sweeper/gen/sweeper/v1/sweeperv1connect"
+	"github.com/nightmarlin/sweeper/lobby"
+	"github.com/nightmarlin/sweeper/replay"
+	"github.com/nightmarlin/sweeper/tournament"
 )
 
 type Connect struct {
 	sweeperv1connect.UnimplementedSweeperServiceHandler
 
-	svc sweeper.Service
+	svc         sweeper.Service
+	lobby       *lobby.Lobby
+	tournaments *tournament.Manager
+
+	spectateDelay time.Duration
 }
 
-func NewConnect(svc sweeper.Service) Connect {
-	return Connect{svc: svc}
+// NewConnect creates a Connect handler. Spectators see each game spectateDelay
+// after its players do, though only Auth stops them claiming to be a player
+// to see it sooner.
+func NewConnect(
+	svc sweeper.Service,
+	lobby *lobby.Lobby,
+	tournaments *tournament.Manager,
+	spectateDelay time.Duration,
+) Connect {
+	return Connect{
+		svc:           svc,
+		lobby:         lobby,
+		tournaments:   tournaments,
+		spectateDelay: spectateDelay,
+	}
 }
 
 func (h Connect) StartGame(
@@ -29,17 +52,32 @@ func (h Connect) StartGame(
 ) (*connect.Response[sweeperv1.StartGameResponse], error) {
 	g, err := h.svc.StartGame(
 		ctx,
-		sweeper.Board{
-			Width:  int(req.Msg.Board.Width),
-			Height: int(req.Msg.Board.Height),
-			Mines:  int(req.Msg.Board.Mines),
-		},
+		sweeper.PlayerID(req.Msg.PlayerId),
+		sweeperv1.BoardToInternalBoard(req.Msg.Board),
 	)
 	if err != nil {
 		return nil, mapErr(err)
 	}
 	return &connect.Response[sweeperv1.StartGameResponse]{
-		Msg: &sweeperv1.StartGameResponse{Game: sweeperv1.InternalGameToGame(g)},
+		Msg: &sweeperv1.StartGameResponse{Game: sweeperv1.InternalGameToGame(g, h.svc.Now())},
+	}, nil
+}
+
+func (h Connect) StartGameFromLayout(
+	ctx context.Context,
+	req *connect.Request[sweeperv1.StartGameFromLayoutRequest],
+) (*connect.Response[sweeperv1.StartGameFromLayoutResponse], error) {
+	board, layout, err := sweeperv1.LayoutToInternalLayout(req.Msg)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	g, err := h.svc.StartGameFromLayout(ctx, sweeper.PlayerID(req.Msg.PlayerId), board, layout)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	return &connect.Response[sweeperv1.StartGameFromLayoutResponse]{
+		Msg: &sweeperv1.StartGameFromLayoutResponse{Game: sweeperv1.InternalGameToGame(g, h.svc.Now())},
 	}, nil
 }
 
@@ -52,13 +90,17 @@ func (h Connect) GetGame(
 		return nil, err
 	}
 
-	g, err := h.svc.GetGame(ctx, id)
+	if err := validateRegion(req.Msg.Region); err != nil {
+		return nil, err
+	}
+
+	g, err := h.svc.ViewGame(ctx, id, sweeper.PlayerID(req.Msg.PlayerId))
 	if err != nil {
 		return nil, mapErr(err)
 	}
 
 	return &connect.Response[sweeperv1.GetGameResponse]{
-		Msg: &sweeperv1.GetGameResponse{Game: sweeperv1.InternalGameToGame(g)},
+		Msg: &sweeperv1.GetGameResponse{Game: h.gameRegion(g, req.Msg.Region)},
 	}, nil
 }
 
@@ -71,17 +113,31 @@ func (h Connect) MakeMove(
 		return nil, err
 	}
 
+	if err := validateRegion(req.Msg.Region); err != nil {
+		return nil, err
+	}
+
 	var g *sweeper.Game
 
 	switch m := req.Msg.Move.(type) {
 	case *sweeperv1.MakeMoveRequest_End:
-		g, err = h.svc.EndGame(ctx, id)
+		g, err = h.svc.EndGame(ctx, id, sweeper.PlayerID(req.Msg.PlayerId))
 
 	case *sweeperv1.MakeMoveRequest_Cell:
+		if m.Cell.Action == sweeperv1.CellMoveAction_CHORD {
+			g, err = h.svc.Chord(
+				ctx,
+				id,
+				sweeper.PlayerID(req.Msg.PlayerId),
+				sweeperv1.CellMoveToInternalCellRef(m.Cell),
+			)
+			break
+		}
 		g, err = h.svc.MakeMove(
 			ctx,
 			id,
-			sweeper.CellRef{Row: int(m.Cell.Row), Column: int(m.Cell.Column)},
+			sweeper.PlayerID(req.Msg.PlayerId),
+			sweeperv1.CellMoveToInternalCellRef(m.Cell),
 			sweeperv1.CellMoveActionToInternalCellState(m.Cell.Action),
 		)
 
@@ -96,7 +152,402 @@ func (h Connect) MakeMove(
 	}
 
 	return &connect.Response[sweeperv1.MakeMoveResponse]{
-		Msg: &sweeperv1.MakeMoveResponse{Game: sweeperv1.InternalGameToGame(g)},
+		Msg: &sweeperv1.MakeMoveResponse{Game: h.gameRegion(g, req.Msg.Region)},
+	}, nil
+}
+
+// validateRegion checks the region of a Game requested, if any, before any
+// moves are made that it would be returned after.
+func validateRegion(r *sweeperv1.Region) error {
+	if r == nil {
+		return nil
+	}
+	if err := sweeperv1.RegionToInternalRegion(r, sweeper.Region{}).Validate(); err != nil {
+		return mapErr(err)
+	}
+	return nil
+}
+
+// gameRegion converts the Game, holding only the Cells in the region if there
+// is one.
+func (h Connect) gameRegion(g *sweeper.Game, r *sweeperv1.Region) *sweeperv1.Game {
+	if r == nil {
+		return sweeperv1.InternalGameToGame(g, h.svc.Now())
+	}
+	return sweeperv1.InternalGameRegionToGame(g, h.svc.Now(), sweeperv1.RegionToInternalRegion(r, sweeper.Region{}))
+}
+
+func (h Connect) MakeMoves(
+	ctx context.Context,
+	req *connect.Request[sweeperv1.MakeMovesRequest],
+) (*connect.Response[sweeperv1.MakeMovesResponse], error) {
+	id, err := parseUUID(req.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	moves := make([]sweeper.CellMove, 0, len(req.Msg.Moves))
+	for _, m := range req.Msg.Moves {
+		moves = append(moves, sweeperv1.CellMoveToInternalCellMove(m))
+	}
+
+	g, applied, err := h.svc.MakeMoves(ctx, id, sweeper.PlayerID(req.Msg.PlayerId), moves)
+	if err != nil {
+		cErr := mapErr(err)
+
+		var mErr *sweeper.MoveError
+		if errors.As(err, &mErr) {
+			if d, dErr := connect.NewErrorDetail(&sweeperv1.MoveFailure{Index: int32(mErr.Index)}); dErr == nil {
+				cErr.AddDetail(d)
+			}
+		}
+		return nil, cErr
+	}
+
+	return &connect.Response[sweeperv1.MakeMovesResponse]{
+		Msg: &sweeperv1.MakeMovesResponse{
+			Game:    sweeperv1.InternalGameToGame(g, h.svc.Now()),
+			Applied: int32(applied),
+		},
+	}, nil
+}
+
+func (h Connect) ExportGame(
+	ctx context.Context,
+	req *connect.Request[sweeperv1.ExportGameRequest],
+) (*connect.Response[sweeperv1.ExportGameResponse], error) {
+	id, err := parseUUID(req.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	g, complete, err := h.svc.ExportGame(ctx, id, sweeper.PlayerID(req.Msg.PlayerId))
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	b, err := boardtext.FromGame(g, complete)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	text, err := boardtext.Marshal(b)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	return &connect.Response[sweeperv1.ExportGameResponse]{
+		Msg: &sweeperv1.ExportGameResponse{Board: string(text), Complete: complete},
+	}, nil
+}
+
+func (h Connect) ExportReplay(
+	ctx context.Context,
+	req *connect.Request[sweeperv1.ExportReplayRequest],
+) (*connect.Response[sweeperv1.ExportReplayResponse], error) {
+	id, err := parseUUID(req.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	g, complete, err := h.svc.ExportGame(ctx, id, sweeper.PlayerID(req.Msg.PlayerId))
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	if !complete {
+		// the replay holds the board's mines, so mustn't be seen mid-match.
+		return nil, mapErr(replay.ErrUnfinished)
+	}
+
+	r, err := replay.FromGame(g)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	var buf strings.Builder
+	if err := replay.Encode(&buf, r); err != nil {
+		return nil, mapErr(err)
+	}
+
+	return &connect.Response[sweeperv1.ExportReplayResponse]{
+		Msg: &sweeperv1.ExportReplayResponse{Replay: buf.String()},
+	}, nil
+}
+
+func (h Connect) InvitePlayer(
+	ctx context.Context,
+	req *connect.Request[sweeperv1.InvitePlayerRequest],
+) (*connect.Response[sweeperv1.InvitePlayerResponse], error) {
+	id, err := parseUUID(req.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := h.svc.InvitePlayer(
+		ctx,
+		id,
+		sweeper.PlayerID(req.Msg.PlayerId),
+		sweeper.PlayerID(req.Msg.InviteeId),
+	)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	return &connect.Response[sweeperv1.InvitePlayerResponse]{
+		Msg: &sweeperv1.InvitePlayerResponse{Game: sweeperv1.InternalGameToGame(g, h.svc.Now())},
+	}, nil
+}
+
+func (h Connect) JoinGame(
+	ctx context.Context,
+	req *connect.Request[sweeperv1.JoinGameRequest],
+) (*connect.Response[sweeperv1.JoinGameResponse], error) {
+	id, err := parseUUID(req.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := h.svc.JoinGame(ctx, id, sweeper.PlayerID(req.Msg.PlayerId))
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	return &connect.Response[sweeperv1.JoinGameResponse]{
+		Msg: &sweeperv1.JoinGameResponse{Game: sweeperv1.InternalGameToGame(g, h.svc.Now())},
+	}, nil
+}
+
+func (h Connect) Spectate(
+	ctx context.Context,
+	req *connect.Request[sweeperv1.SpectateRequest],
+	stream *connect.ServerStream[sweeperv1.SpectateResponse],
+) error {
+	id, err := parseUUID(req.Msg.GameId)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.Spectate(
+		ctx,
+		id,
+		h.spectateDelay,
+		func(g *sweeper.Game) error {
+			return stream.Send(
+				&sweeperv1.SpectateResponse{Game: sweeperv1.InternalGameToGame(g, h.svc.Now())},
+			)
+		},
+	); err != nil {
+		return mapErr(err)
+	}
+	return nil
+}
+
+func (h Connect) StartMatch(
+	ctx context.Context,
+	req *connect.Request[sweeperv1.StartMatchRequest],
+) (*connect.Response[sweeperv1.StartMatchResponse], error) {
+	players := make([]sweeper.PlayerID, 0, len(req.Msg.PlayerIds))
+	for _, p := range req.Msg.PlayerIds {
+		players = append(players, sweeper.PlayerID(p))
+	}
+
+	m, err := h.svc.StartMatch(ctx, players, sweeperv1.BoardToInternalBoard(req.Msg.Board))
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	// fetch the freshly started games so everyone's progress is included.
+	m, progress, err := h.svc.GetMatch(ctx, m.ID)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	return &connect.Response[sweeperv1.StartMatchResponse]{
+		Msg: &sweeperv1.StartMatchResponse{Match: sweeperv1.InternalMatchToMatch(m, progress)},
+	}, nil
+}
+
+func (h Connect) GetMatch(
+	ctx context.Context,
+	req *connect.Request[sweeperv1.GetMatchRequest],
+) (*connect.Response[sweeperv1.GetMatchResponse], error) {
+	id, err := parseUUID(req.Msg.MatchId)
+	if err != nil {
+		return nil, err
+	}
+
+	m, progress, err := h.svc.ViewMatch(ctx, id, sweeper.PlayerID(req.Msg.PlayerId))
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	return &connect.Response[sweeperv1.GetMatchResponse]{
+		Msg: &sweeperv1.GetMatchResponse{Match: sweeperv1.InternalMatchToMatch(m, progress)},
+	}, nil
+}
+
+func (h Connect) CreateTournament(
+	ctx context.Context,
+	req *connect.Request[sweeperv1.CreateTournamentRequest],
+) (*connect.Response[sweeperv1.CreateTournamentResponse], error) {
+	players := make([]sweeper.PlayerID, 0, len(req.Msg.PlayerIds))
+	for _, p := range req.Msg.PlayerIds {
+		players = append(players, sweeper.PlayerID(p))
+	}
+
+	t, err := h.tournaments.Create(
+		ctx,
+		sweeper.PlayerID(req.Msg.OrganiserId),
+		players,
+		sweeperv1.BoardToInternalBoard(req.Msg.Board),
+		tournamentFormatToInternalTournamentFormat(req.Msg.Format),
+		int(req.Msg.BestOf),
+	)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	return &connect.Response[sweeperv1.CreateTournamentResponse]{
+		Msg: &sweeperv1.CreateTournamentResponse{Tournament: internalTournamentToTournament(t)},
+	}, nil
+}
+
+func (h Connect) GetTournament(
+	ctx context.Context,
+	req *connect.Request[sweeperv1.GetTournamentRequest],
+) (*connect.Response[sweeperv1.GetTournamentResponse], error) {
+	id, err := parseUUID(req.Msg.TournamentId)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := h.tournaments.Get(ctx, id)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	return &connect.Response[sweeperv1.GetTournamentResponse]{
+		Msg: &sweeperv1.GetTournamentResponse{Tournament: internalTournamentToTournament(t)},
+	}, nil
+}
+
+func (h Connect) Queue(
+	ctx context.Context,
+	req *connect.Request[sweeperv1.QueueRequest],
+	stream *connect.ServerStream[sweeperv1.QueueResponse],
+) error {
+	preset := presetToInternalPreset(req.Msg.Preset)
+
+	if err := stream.Send(&sweeperv1.QueueResponse{
+		Status: &sweeperv1.QueueResponse_Queued{
+			Queued: &sweeperv1.Queued{PlayersWaiting: int32(h.lobby.Waiting(preset))},
+		},
+	}); err != nil {
+		return err
+	}
+
+	p, err := h.lobby.Queue(ctx, sweeper.PlayerID(req.Msg.PlayerId), preset)
+	if err != nil {
+		return mapErr(err)
+	}
+
+	return stream.Send(&sweeperv1.QueueResponse{
+		Status: &sweeperv1.QueueResponse_Paired{Paired: internalPairingToPaired(p)},
+	})
+}
+
+func (h Connect) StartEndlessGame(
+	ctx context.Context,
+	req *connect.Request[sweeperv1.StartEndlessGameRequest],
+) (*connect.Response[sweeperv1.StartEndlessGameResponse], error) {
+	region := sweeperv1.RegionToInternalRegion(req.Msg.Region, sweeper.EndlessHome)
+	if err := region.Validate(); err != nil {
+		return nil, mapErr(err)
+	}
+
+	e, err := h.svc.StartEndless(ctx, sweeper.PlayerID(req.Msg.PlayerId), int(req.Msg.MinesPerChunk))
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	res, err := sweeperv1.InternalEndlessToEndlessGame(e, region)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	return &connect.Response[sweeperv1.StartEndlessGameResponse]{
+		Msg: &sweeperv1.StartEndlessGameResponse{Game: res},
+	}, nil
+}
+
+func (h Connect) GetEndlessGame(
+	ctx context.Context,
+	req *connect.Request[sweeperv1.GetEndlessGameRequest],
+) (*connect.Response[sweeperv1.GetEndlessGameResponse], error) {
+	id, err := parseUUID(req.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	region := sweeperv1.RegionToInternalRegion(req.Msg.Region, sweeper.EndlessHome)
+	if err := region.Validate(); err != nil {
+		return nil, mapErr(err)
+	}
+
+	e, err := h.svc.GetEndless(ctx, id)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	res, err := sweeperv1.InternalEndlessToEndlessGame(e, region)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	return &connect.Response[sweeperv1.GetEndlessGameResponse]{
+		Msg: &sweeperv1.GetEndlessGameResponse{Game: res},
+	}, nil
+}
+
+func (h Connect) MakeEndlessMove(
+	ctx context.Context,
+	req *connect.Request[sweeperv1.MakeEndlessMoveRequest],
+) (*connect.Response[sweeperv1.MakeEndlessMoveResponse], error) {
+	id, err := parseUUID(req.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the region is checked first, so a move is never made without its result
+	// being returned.
+	region := sweeperv1.RegionToInternalRegion(req.Msg.Region, sweeper.EndlessHome)
+	if err := region.Validate(); err != nil {
+		return nil, mapErr(err)
+	}
+
+	var e *sweeper.Endless
+
+	switch m := req.Msg.Move.(type) {
+	case *sweeperv1.MakeEndlessMoveRequest_End:
+		e, err = h.svc.EndEndless(ctx, id, sweeper.PlayerID(req.Msg.PlayerId))
+
+	case *sweeperv1.MakeEndlessMoveRequest_Cell:
+		move := sweeperv1.CellMoveToInternalCellMove(m.Cell)
+		move.Ref.Layer = 0
+		e, err = h.svc.MakeEndlessMove(ctx, id, sweeper.PlayerID(req.Msg.PlayerId), move)
+
+	default:
+		return nil, connect.NewError(
+			connect.CodeUnimplemented,
+			fmt.Errorf("unknown move type: %T", m),
+		)
+	}
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	res, err := sweeperv1.InternalEndlessToEndlessGame(e, region)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	return &connect.Response[sweeperv1.MakeEndlessMoveResponse]{
+		Msg: &sweeperv1.MakeEndlessMoveResponse{Game: res},
 	}, nil
 }
 
@@ -109,11 +560,36 @@ func parseUUID(id string) (uuid.UUID, error) {
 }
 
 var knownErrs = map[error]connect.Code{
-	sweeper.ErrGameNotFound: connect.CodeNotFound,
-	sweeper.ErrRevealed:     connect.CodeAlreadyExists,
-	sweeper.ErrFlagged:      connect.CodeFailedPrecondition,
-	sweeper.ErrOutOfBounds:  connect.CodeOutOfRange,
-	sweeper.ErrGameFinished: connect.CodeFailedPrecondition,
+	sweeper.ErrGameNotFound:  connect.CodeNotFound,
+	sweeper.ErrRevealed:      connect.CodeAlreadyExists,
+	sweeper.ErrFlagged:       connect.CodeFailedPrecondition,
+	sweeper.ErrOutOfBounds:   connect.CodeOutOfRange,
+	sweeper.ErrGameFinished:  connect.CodeFailedPrecondition,
+	sweeper.ErrNotAPlayer:    connect.CodePermissionDenied,
+	sweeper.ErrNotInvited:    connect.CodePermissionDenied,
+	sweeper.ErrJoined:        connect.CodeAlreadyExists,
+	sweeper.ErrNotYourTurn:   connect.CodeFailedPrecondition,
+	sweeper.ErrInvalidLayout: connect.CodeInvalidArgument,
+
+	sweeper.ErrMatchNotFound:   connect.CodeNotFound,
+	sweeper.ErrTooFewPlayers:   connect.CodeInvalidArgument,
+	sweeper.ErrDuplicatePlayer: connect.CodeInvalidArgument,
+	sweeper.ErrInMatch:         connect.CodeFailedPrecondition,
+
+	boardtext.ErrInvalidBoard: connect.CodeInvalidArgument,
+	boardtext.ErrUnsupported:  connect.CodeUnimplemented,
+
+	replay.ErrUnsupported: connect.CodeUnimplemented,
+	replay.ErrUnfinished:  connect.CodeFailedPrecondition,
+
+	lobby.ErrInvalidPreset: connect.CodeInvalidArgument,
+	lobby.ErrAlreadyQueued: connect.CodeAlreadyExists,
+	lobby.ErrTimedOut:      connect.CodeDeadlineExceeded,
+
+	tournament.ErrTournamentNotFound: connect.CodeNotFound,
+	tournament.ErrInvalidTournament:  connect.CodeInvalidArgument,
+
+	context.Canceled: connect.CodeCanceled,
 }
 
 func mapErr(err error) *connect.Error {
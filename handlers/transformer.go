@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/nightmarlin/sweeper"
+	sweeperv1 "github.com/nightmarlin/sweeper/gen/sweeper/v1"
+	"github.com/nightmarlin/sweeper/lobby"
+	"github.com/nightmarlin/sweeper/tournament"
+)
+
+// The conversions between the API and the feature packages built on package
+// sweeper live here, so sweeperv1 only depends on package sweeper itself.
+
+func presetToInternalPreset(p *sweeperv1.Preset) lobby.Preset {
+	return lobby.Preset{
+		Board:   sweeperv1.BoardToInternalBoard(p.GetBoard()),
+		Mode:    lobbyModeToInternalMode(p.GetMode()),
+		Players: int(p.GetPlayers()),
+	}
+}
+
+func lobbyModeToInternalMode(m sweeperv1.LobbyMode) lobby.Mode {
+	switch m {
+	case sweeperv1.LobbyMode_VERSUS:
+		return lobby.ModeVersus
+	case sweeperv1.LobbyMode_COOP:
+		return lobby.ModeCoop
+	default:
+		return -1 // rejected by the lobby
+	}
+}
+
+func internalPairingToPaired(p lobby.Pairing) *sweeperv1.Paired {
+	res := &sweeperv1.Paired{GameId: p.GameID.String(), PlayerIds: playerIDsToStrings(p.Players)}
+	if p.MatchID != uuid.Nil {
+		res.MatchId = p.MatchID.String()
+	}
+	return res
+}
+
+func internalTournamentToTournament(t *tournament.Tournament) *sweeperv1.Tournament {
+	res := &sweeperv1.Tournament{
+		Id:          t.ID.String(),
+		OrganiserId: string(t.Organiser),
+		Board:       sweeperv1.InternalBoardToBoard(t.Board),
+		Format:      internalTournamentFormatToTournamentFormat(t.Format),
+		BestOf:      int32(t.BestOf),
+		PlayerIds:   playerIDsToStrings(t.Players),
+		WinnerId:    string(t.Winner),
+	}
+	for _, r := range t.Rounds {
+		round := &sweeperv1.Round{}
+		for _, p := range r.Pairings {
+			pairing := &sweeperv1.Pairing{PlayerIds: playerIDsToStrings(p.Players), WinnerId: string(p.Winner)}
+			for _, w := range p.Wins {
+				pairing.Wins = append(pairing.Wins, int32(w))
+			}
+			for _, h := range p.Heats {
+				heat := &sweeperv1.Heat{
+					MatchId:  h.MatchID.String(),
+					WinnerId: string(h.Winner),
+					Finished: h.Finished,
+				}
+				for _, id := range h.GameIDs {
+					heat.GameIds = append(heat.GameIds, id.String())
+				}
+				for _, d := range h.Times {
+					var pd *durationpb.Duration
+					if d >= 0 {
+						pd = durationpb.New(d)
+					}
+					heat.Times = append(heat.Times, pd)
+				}
+				pairing.Heats = append(pairing.Heats, heat)
+			}
+			round.Pairings = append(round.Pairings, pairing)
+		}
+		res.Rounds = append(res.Rounds, round)
+	}
+	return res
+}
+
+func internalTournamentFormatToTournamentFormat(f tournament.Format) sweeperv1.TournamentFormat {
+	switch f {
+	case tournament.FormatBestOf:
+		return sweeperv1.TournamentFormat_BEST_OF
+	case tournament.FormatFastest:
+		return sweeperv1.TournamentFormat_FASTEST_TIME
+	default:
+		return sweeperv1.TournamentFormat_TOURNAMENT_FORMAT_UNKNOWN
+	}
+}
+
+func tournamentFormatToInternalTournamentFormat(f sweeperv1.TournamentFormat) tournament.Format {
+	switch f {
+	case sweeperv1.TournamentFormat_BEST_OF:
+		return tournament.FormatBestOf
+	case sweeperv1.TournamentFormat_FASTEST_TIME:
+		return tournament.FormatFastest
+	default:
+		return -1 // rejected by the manager
+	}
+}
+
+func playerIDsToStrings(ids []sweeper.PlayerID) []string {
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, string(id))
+	}
+	return res
+}
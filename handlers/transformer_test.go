@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+	"github.com/nightmarlin/sweeper/tournament"
+)
+
+func TestInternalTournamentToTournament_times(t *testing.T) {
+	t.Parallel()
+
+	res := internalTournamentToTournament(&tournament.Tournament{
+		ID:      uuid.New(),
+		Players: []sweeper.PlayerID{"alice", "bob"},
+		Rounds: []tournament.Round{{Pairings: []tournament.Pairing{{
+			Players: []sweeper.PlayerID{"alice", "bob"},
+			Wins:    []int{1, 0},
+			Heats: []tournament.Heat{{
+				GameIDs:  []uuid.UUID{uuid.New(), uuid.New()},
+				Times:    []time.Duration{0, -1},
+				Winner:   "alice",
+				Finished: true,
+			}},
+		}}}},
+	})
+
+	// winning in no time is sent as an explicit zero, not left unset.
+	times := res.Rounds[0].Pairings[0].Heats[0].Times
+	if times[0] == nil || times[0].AsDuration() != 0 {
+		t.Errorf("want alice's time to be 0s, got %v", times[0])
+	}
+	if times[1] != nil {
+		t.Errorf("want bob's time to be unset, got %v", times[1])
+	}
+}
@@ -8,22 +8,34 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/nightmarlin/sweeper"
+	"github.com/nightmarlin/sweeper/tournament"
 )
 
+// Store keeps Games, Matches, Endless Games and Tournaments in memory. They are
+// copied on their way in and out, so callers may safely hold onto them while
+// other goroutines mutate the Store.
 type Store struct {
 	mux sync.RWMutex
-	s   map[uuid.UUID]sweeper.Game
+	s   map[uuid.UUID]*sweeper.Game
+	m   map[uuid.UUID]*sweeper.Match
+	e   map[uuid.UUID]*sweeper.Endless
+	t   map[uuid.UUID]*tournament.Tournament
 }
 
 func NewStore() *Store {
-	return &Store{s: make(map[uuid.UUID]sweeper.Game)}
+	return &Store{
+		s: make(map[uuid.UUID]*sweeper.Game),
+		m: make(map[uuid.UUID]*sweeper.Match),
+		e: make(map[uuid.UUID]*sweeper.Endless),
+		t: make(map[uuid.UUID]*tournament.Tournament),
+	}
 }
 
 func (s *Store) SaveGame(_ context.Context, g *sweeper.Game) error {
 	defer s.mux.Unlock()
 	s.mux.Lock()
 
-	s.s[g.ID] = *g
+	s.s[g.ID] = g.Clone()
 	return nil
 }
 
@@ -32,7 +44,7 @@ func (s *Store) getGame(gameID uuid.UUID) (*sweeper.Game, error) {
 	if !ok {
 		return nil, sweeper.ErrGameNotFound
 	}
-	return &g, nil
+	return g.Clone(), nil
 }
 
 func (s *Store) GetGame(_ context.Context, gameID uuid.UUID) (*sweeper.Game, error) {
@@ -58,6 +70,111 @@ func (s *Store) MutateGame(
 		return nil, fmt.Errorf("error in mutator: %w", err)
 	}
 
-	s.s[gameID] = *g
+	s.s[gameID] = g.Clone()
 	return g, nil
 }
+
+func (s *Store) SaveMatch(_ context.Context, m *sweeper.Match) error {
+	defer s.mux.Unlock()
+	s.mux.Lock()
+
+	s.m[m.ID] = m.Clone()
+	return nil
+}
+
+func (s *Store) getMatch(matchID uuid.UUID) (*sweeper.Match, error) {
+	m, ok := s.m[matchID]
+	if !ok {
+		return nil, sweeper.ErrMatchNotFound
+	}
+	return m.Clone(), nil
+}
+
+func (s *Store) GetMatch(_ context.Context, matchID uuid.UUID) (*sweeper.Match, error) {
+	defer s.mux.RUnlock()
+	s.mux.RLock()
+	return s.getMatch(matchID)
+}
+
+func (s *Store) MutateMatch(
+	ctx context.Context,
+	matchID uuid.UUID,
+	mut sweeper.MatchMutator,
+) (*sweeper.Match, error) {
+	defer s.mux.Unlock()
+	s.mux.Lock()
+
+	m, err := s.getMatch(matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := mut(ctx, m); err != nil {
+		return nil, fmt.Errorf("error in mutator: %w", err)
+	}
+
+	s.m[matchID] = m.Clone()
+	return m, nil
+}
+
+func (s *Store) SaveEndless(_ context.Context, e *sweeper.Endless) error {
+	defer s.mux.Unlock()
+	s.mux.Lock()
+
+	s.e[e.ID] = e.Clone()
+	return nil
+}
+
+func (s *Store) getEndless(gameID uuid.UUID) (*sweeper.Endless, error) {
+	e, ok := s.e[gameID]
+	if !ok {
+		return nil, sweeper.ErrGameNotFound
+	}
+	return e.Clone(), nil
+}
+
+func (s *Store) GetEndless(_ context.Context, gameID uuid.UUID) (*sweeper.Endless, error) {
+	defer s.mux.RUnlock()
+	s.mux.RLock()
+	return s.getEndless(gameID)
+}
+
+func (s *Store) MutateEndless(
+	ctx context.Context,
+	gameID uuid.UUID,
+	mut sweeper.EndlessMutator,
+) (*sweeper.Endless, error) {
+	defer s.mux.Unlock()
+	s.mux.Lock()
+
+	e, err := s.getEndless(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := mut(ctx, e); err != nil {
+		return nil, fmt.Errorf("error in mutator: %w", err)
+	}
+
+	s.e[gameID] = e.Clone()
+	return e, nil
+}
+
+func (s *Store) SaveTournament(_ context.Context, t *tournament.Tournament) error {
+	defer s.mux.Unlock()
+	s.mux.Lock()
+
+	s.t[t.ID] = t.Clone()
+	return nil
+}
+
+func (s *Store) GetTournament(_ context.Context, id uuid.UUID) (*tournament.Tournament, error) {
+	defer s.mux.RUnlock()
+	s.mux.RLock()
+
+	t, ok := s.t[id]
+	if !ok {
+		return nil, tournament.ErrTournamentNotFound
+	}
+	return t.Clone(), nil
+}
@@ -0,0 +1,92 @@
+// Package fakeclock provides a sweeper.Clock whose time only moves when told
+// to, for deterministic tests.
+package fakeclock
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Clock is a sweeper.Clock whose time only moves when Advance or Set is
+// called.
+type Clock struct {
+	mux    sync.Mutex
+	now    time.Time
+	timers []*timer
+}
+
+type timer struct {
+	at      time.Time
+	f       func()
+	stopped bool
+}
+
+func New(now time.Time) *Clock {
+	return &Clock{now: now}
+}
+
+func (c *Clock) Now() time.Time {
+	defer c.mux.Unlock()
+	c.mux.Lock()
+	return c.now
+}
+
+func (c *Clock) AfterFunc(d time.Duration, f func()) func() bool {
+	defer c.mux.Unlock()
+	c.mux.Lock()
+
+	t := &timer{at: c.now.Add(d), f: f}
+	c.timers = append(c.timers, t)
+	return func() bool {
+		defer c.mux.Unlock()
+		c.mux.Lock()
+		stopped := !t.stopped
+		t.stopped = true
+		return stopped
+	}
+}
+
+// Advance moves the clock forwards by d, synchronously running any timers that
+// fire in that period in the order they fire.
+func (c *Clock) Advance(d time.Duration) {
+	c.mux.Lock()
+	c.now = c.now.Add(d)
+
+	var due []*timer
+	for _, t := range c.timers {
+		if !t.stopped && !t.at.After(c.now) {
+			t.stopped = true
+			due = append(due, t)
+		}
+	}
+	c.timers = slices.DeleteFunc(c.timers, func(t *timer) bool { return t.stopped })
+	c.mux.Unlock()
+
+	slices.SortStableFunc(due, func(a, b *timer) int { return a.at.Compare(b.at) })
+	for _, t := range due {
+		t.f()
+	}
+}
+
+// Set moves the clock to now without running any timers, as if they were
+// running late.
+func (c *Clock) Set(now time.Time) {
+	defer c.mux.Unlock()
+	c.mux.Lock()
+	c.now = now
+}
+
+// Timers returns the number of timers waiting to fire.
+func (c *Clock) Timers() int {
+	defer c.mux.Unlock()
+	c.mux.Lock()
+
+	var n int
+	for _, t := range c.timers {
+		if !t.stopped {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,130 @@
+package sweeper
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// A Layout is a hand-authored placement of mines, for starting a Game without
+// generating one.
+type Layout struct {
+	// Mines are the Cells containing mines. A Cell listed more than once holds
+	// more than one mine.
+	Mines []CellRef
+	// Reveal is the Cell revealed at the start of the Game, if any.
+	Reveal *CellRef
+}
+
+const (
+	layoutMine   = '*'
+	layoutSafe   = '.'
+	layoutReveal = 'o'
+)
+
+// ParseLayout parses a Layout from a grid of Cells, one row per line, where
+// '*' is a mine, '.' is a safe Cell, and 'o' is the safe Cell to reveal at the
+// start of the Game. The layers of deeper Boards are separated by blank lines.
+//
+// The returned Board only has its Width, Height, Depth and Mines set.
+func ParseLayout(grid string) (Board, Layout, error) {
+	var (
+		b      Board
+		layout Layout
+		layers = strings.Split(strings.TrimSpace(strings.ReplaceAll(grid, "\r\n", "\n")), "\n\n")
+	)
+
+	b.Depth = len(layers)
+	for layer, l := range layers {
+		rows := strings.Split(l, "\n")
+		if layer == 0 {
+			b.Height = len(rows)
+		}
+		if len(rows) != b.Height {
+			return Board{}, Layout{}, fmt.Errorf(
+				"%w: layer %d has %d rows, want %d", ErrInvalidLayout, layer+1, len(rows), b.Height,
+			)
+		}
+
+		for row, r := range rows {
+			r = strings.TrimSpace(r)
+			width := utf8.RuneCountInString(r)
+			if layer == 0 && row == 0 {
+				b.Width = width
+			}
+			if width != b.Width {
+				return Board{}, Layout{}, fmt.Errorf(
+					"%w: row %d has %d cells, want %d", ErrInvalidLayout, row+1, width, b.Width,
+				)
+			}
+
+			// cells are counted in runes, not bytes.
+			col := -1
+			for _, c := range r {
+				col++
+				ref := CellRef{Layer: layer, Row: row, Column: col}
+				switch c {
+				case layoutMine:
+					layout.Mines = append(layout.Mines, ref)
+				case layoutSafe:
+				case layoutReveal:
+					if layout.Reveal != nil {
+						return Board{}, Layout{}, fmt.Errorf("%w: more than one starting cell", ErrInvalidLayout)
+					}
+					layout.Reveal = &ref
+				default:
+					return Board{}, Layout{}, fmt.Errorf("%w: unknown cell %q", ErrInvalidLayout, c)
+				}
+			}
+		}
+	}
+
+	b.Mines = len(layout.Mines)
+	if b.Depth == 1 {
+		b.Depth = 0
+	}
+	return b, layout, nil
+}
+
+// NewGameFromLayout creates a Game on the Board with its mines placed as in the
+// Layout, rather than at random. The Board's Mines are taken from the Layout.
+func NewGameFromLayout(idGen IDGenerator, board Board, layout Layout) (*Game, error) {
+	board.Mines = len(layout.Mines)
+	if err := board.validate(); err != nil {
+		return nil, err
+	}
+
+	g := newGame(idGen, board)
+
+	for _, ref := range layout.Mines {
+		if !board.Contains(ref) {
+			return nil, fmt.Errorf("%w: mine %+v is not on the board", ErrOutOfBounds, ref)
+		}
+
+		c := g.Cells.At(ref)
+		if c.Mines >= board.maxMinesPerCell() {
+			return nil, fmt.Errorf(
+				"%w: cell %+v holds more than %d mines", ErrOutOfBounds, ref, board.maxMinesPerCell(),
+			)
+		}
+		c.Mines++
+		g.Cells.Set(ref, c)
+	}
+
+	g.countMines()
+
+	if ref := layout.Reveal; ref != nil {
+		switch {
+		case !board.Contains(*ref):
+			return nil, fmt.Errorf("%w: starting cell %+v is not on the board", ErrOutOfBounds, *ref)
+		case g.Cells.At(*ref).ContainsMine():
+			return nil, fmt.Errorf("%w: starting cell %+v contains a mine", ErrInvalidLayout, *ref)
+		}
+		opening := *ref
+		g.revealCell(opening)
+		g.Opening = &opening
+		g.tryWin()
+	}
+
+	return g, nil
+}
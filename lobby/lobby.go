@@ -0,0 +1,234 @@
+// Package lobby pairs players queueing for the same Preset into games.
+package lobby
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+)
+
+type Mode int
+
+const (
+	ModeVersus = Mode(iota) // Each player gets their own Game in a sweeper.Match.
+	ModeCoop                // Every player shares a single Game.
+)
+
+// A Preset describes the games players can queue for.
+type Preset struct {
+	Board   sweeper.Board
+	Mode    Mode
+	Players int // How many players take part in each game.
+}
+
+// A Pairing is the game a queued player was paired into.
+type Pairing struct {
+	GameID  uuid.UUID
+	MatchID uuid.UUID // Only set for ModeVersus.
+	Players []sweeper.PlayerID
+}
+
+// Service is the subset of sweeper.Service the Lobby uses to start games.
+type Service interface {
+	StartGame(ctx context.Context, player sweeper.PlayerID, board sweeper.Board) (*sweeper.Game, error)
+	InvitePlayer(ctx context.Context, gameID uuid.UUID, inviter, invitee sweeper.PlayerID) (*sweeper.Game, error)
+	JoinGame(ctx context.Context, gameID uuid.UUID, player sweeper.PlayerID) (*sweeper.Game, error)
+	StartMatch(ctx context.Context, players []sweeper.PlayerID, board sweeper.Board) (*sweeper.Match, error)
+}
+
+// Lobby keeps a queue of players for each Preset, starting a game as soon as
+// enough players are waiting.
+type Lobby struct {
+	svc     Service
+	clock   sweeper.Clock
+	timeout time.Duration
+
+	mux    sync.Mutex
+	queues map[Preset][]*waiter
+}
+
+type waiter struct {
+	player sweeper.PlayerID
+	res    chan result // buffered, so the waiter's result can always be delivered
+	stop   func() bool
+}
+
+type result struct {
+	pairing Pairing
+	err     error
+}
+
+// New creates a Lobby. Players that have waited for longer than timeout are
+// removed from their queue.
+func New(svc Service, clock sweeper.Clock, timeout time.Duration) *Lobby {
+	return &Lobby{
+		svc:     svc,
+		clock:   clock,
+		timeout: timeout,
+		queues:  make(map[Preset][]*waiter),
+	}
+}
+
+// Waiting returns how many players are queueing for the Preset.
+func (l *Lobby) Waiting(p Preset) int {
+	defer l.mux.Unlock()
+	l.mux.Lock()
+	return len(l.queues[p])
+}
+
+// Queue adds the player to the Preset's queue, blocking until they are paired
+// into a game, ctx is cancelled, or they time out.
+func (l *Lobby) Queue(ctx context.Context, player sweeper.PlayerID, p Preset) (Pairing, error) {
+	switch {
+	case player == "":
+		return Pairing{}, fmt.Errorf("%w: player is required", ErrInvalidPreset)
+	case p.Players < 2:
+		return Pairing{}, fmt.Errorf("%w: games need at least 2 players", ErrInvalidPreset)
+	case p.Mode != ModeVersus && p.Mode != ModeCoop:
+		return Pairing{}, fmt.Errorf("%w: unknown mode (%d)", ErrInvalidPreset, p.Mode)
+	}
+
+	w := &waiter{player: player, res: make(chan result, 1)}
+
+	l.mux.Lock()
+	q := l.queues[p]
+	if slices.ContainsFunc(q, func(o *waiter) bool { return o.player == player }) {
+		l.mux.Unlock()
+		return Pairing{}, ErrAlreadyQueued
+	}
+	q = append(q, w)
+
+	var paired []*waiter
+	if len(q) == p.Players {
+		paired, q = q, nil
+	} else {
+		w.stop = l.clock.AfterFunc(l.timeout, func() {
+			if l.remove(p, w) {
+				w.res <- result{err: ErrTimedOut}
+			}
+		})
+	}
+	l.queues[p] = q
+	l.mux.Unlock()
+
+	if paired != nil {
+		l.pair(ctx, p, paired)
+	}
+
+	select {
+	case res := <-w.res:
+		return res.pairing, res.err
+
+	case <-ctx.Done():
+		if l.remove(p, w) {
+			return Pairing{}, ctx.Err()
+		}
+		// paired just as ctx was cancelled. the game exists now, so report it.
+		res := <-w.res
+		return res.pairing, res.err
+	}
+}
+
+// remove takes the waiter out of the Preset's queue, reporting whether it was
+// still waiting.
+func (l *Lobby) remove(p Preset, w *waiter) bool {
+	defer l.mux.Unlock()
+	l.mux.Lock()
+
+	q := l.queues[p]
+	i := slices.Index(q, w)
+	if i < 0 {
+		return false
+	}
+	if w.stop != nil {
+		w.stop()
+	}
+
+	if q = slices.Delete(q, i, i+1); len(q) == 0 {
+		delete(l.queues, p)
+	} else {
+		l.queues[p] = q
+	}
+	return true
+}
+
+// pair starts a game for the waiters, and tells each of them where to find it.
+func (l *Lobby) pair(ctx context.Context, p Preset, waiters []*waiter) {
+	players := make([]sweeper.PlayerID, 0, len(waiters))
+	for _, w := range waiters {
+		if w.stop != nil {
+			w.stop()
+		}
+		players = append(players, w.player)
+	}
+
+	// the game must be started even if the player that filled the queue gives
+	// up, as everyone else is still waiting for it.
+	ctx = context.WithoutCancel(ctx)
+
+	var (
+		pairings []Pairing
+		err      error
+	)
+	switch p.Mode {
+	case ModeVersus:
+		pairings, err = l.startVersus(ctx, p, players)
+	case ModeCoop:
+		pairings, err = l.startCoop(ctx, p, players)
+	}
+
+	for i, w := range waiters {
+		if err != nil {
+			w.res <- result{err: err}
+			continue
+		}
+		w.res <- result{pairing: pairings[i]}
+	}
+}
+
+func (l *Lobby) startVersus(ctx context.Context, p Preset, players []sweeper.PlayerID) ([]Pairing, error) {
+	m, err := l.svc.StartMatch(ctx, players, p.Board)
+	if err != nil {
+		return nil, fmt.Errorf("starting match: %w", err)
+	}
+
+	res := make([]Pairing, 0, len(m.Players))
+	for _, mp := range m.Players {
+		res = append(res, Pairing{GameID: mp.GameID, MatchID: m.ID, Players: players})
+	}
+	return res, nil
+}
+
+func (l *Lobby) startCoop(ctx context.Context, p Preset, players []sweeper.PlayerID) ([]Pairing, error) {
+	g, err := l.svc.StartGame(ctx, players[0], p.Board)
+	if err != nil {
+		return nil, fmt.Errorf("starting game: %w", err)
+	}
+
+	for _, invitee := range players[1:] {
+		if _, err := l.svc.InvitePlayer(ctx, g.ID, players[0], invitee); err != nil {
+			return nil, fmt.Errorf("inviting %s: %w", invitee, err)
+		}
+		if _, err := l.svc.JoinGame(ctx, g.ID, invitee); err != nil {
+			return nil, fmt.Errorf("joining as %s: %w", invitee, err)
+		}
+	}
+
+	res := make([]Pairing, len(players))
+	for i := range res {
+		res[i] = Pairing{GameID: g.ID, Players: players}
+	}
+	return res, nil
+}
+
+var (
+	ErrInvalidPreset = fmt.Errorf("invalid preset")
+	ErrAlreadyQueued = fmt.Errorf("player is already queueing for this preset")
+	ErrTimedOut      = fmt.Errorf("timed out waiting for other players")
+)
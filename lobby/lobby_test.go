@@ -0,0 +1,170 @@
+package lobby_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+	"github.com/nightmarlin/sweeper/infra/memory"
+	"github.com/nightmarlin/sweeper/internal/fakeclock"
+	"github.com/nightmarlin/sweeper/lobby"
+)
+
+func newLobby() (*lobby.Lobby, sweeper.Service, *fakeclock.Clock) {
+	clock := fakeclock.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	svc := sweeper.NewService(memory.NewStore(), uuid.New, sweeper.SeededNumberGenerator(1), clock)
+	return lobby.New(svc, clock, time.Minute), svc, clock
+}
+
+type queued struct {
+	pairing lobby.Pairing
+	err     error
+}
+
+// queue queues the player in the background, waiting until they're in the
+// queue before returning.
+func queue(ctx context.Context, l *lobby.Lobby, player sweeper.PlayerID, p lobby.Preset) <-chan queued {
+	waiting := l.Waiting(p)
+
+	res := make(chan queued, 1)
+	go func() {
+		pairing, err := l.Queue(ctx, player, p)
+		res <- queued{pairing: pairing, err: err}
+	}()
+
+	for l.Waiting(p) == waiting {
+		runtime.Gosched()
+	}
+	return res
+}
+
+func TestLobby_Queue(t *testing.T) {
+	t.Parallel()
+
+	board := sweeper.Board{Width: 5, Height: 5, Mines: 3}
+
+	t.Run("versus players each get a game in one match", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		l, svc, _ := newLobby()
+		preset := lobby.Preset{Board: board, Mode: lobby.ModeVersus, Players: 2}
+
+		alice := queue(ctx, l, "alice", preset)
+		bob, err := l.Queue(ctx, "bob", preset)
+		if err != nil {
+			t.Fatalf("queueing bob: %v", err)
+		}
+		res := <-alice
+		if res.err != nil {
+			t.Fatalf("queueing alice: %v", res.err)
+		}
+
+		if res.pairing.MatchID != bob.MatchID {
+			t.Errorf("want both players in match %s, alice got %s", bob.MatchID, res.pairing.MatchID)
+		}
+		if res.pairing.GameID == bob.GameID {
+			t.Error("want each player to have their own game")
+		}
+
+		g, err := svc.GetGame(ctx, bob.GameID)
+		if err != nil {
+			t.Fatalf("getting bob's game: %v", err)
+		}
+		if g.MatchID != bob.MatchID {
+			t.Errorf("want game in match %s, got %s", bob.MatchID, g.MatchID)
+		}
+	})
+
+	t.Run("co-op players share a game", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		l, svc, _ := newLobby()
+		preset := lobby.Preset{Board: board, Mode: lobby.ModeCoop, Players: 3}
+
+		alice := queue(ctx, l, "alice", preset)
+		bob := queue(ctx, l, "bob", preset)
+		carol, err := l.Queue(ctx, "carol", preset)
+		if err != nil {
+			t.Fatalf("queueing carol: %v", err)
+		}
+
+		for _, res := range []queued{<-alice, <-bob} {
+			if res.err != nil {
+				t.Fatalf("queueing: %v", res.err)
+			}
+			if res.pairing.GameID != carol.GameID {
+				t.Errorf("want game %s, got %s", carol.GameID, res.pairing.GameID)
+			}
+		}
+
+		g, err := svc.GetGame(ctx, carol.GameID)
+		if err != nil {
+			t.Fatalf("getting game: %v", err)
+		}
+		for _, p := range []sweeper.PlayerID{"alice", "bob", "carol"} {
+			if !g.CanMove(p) {
+				t.Errorf("want %s to be a player", p)
+			}
+		}
+	})
+
+	t.Run("players time out", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		l, _, clock := newLobby()
+		preset := lobby.Preset{Board: board, Mode: lobby.ModeVersus, Players: 2}
+
+		alice := queue(ctx, l, "alice", preset)
+		clock.Advance(time.Minute)
+
+		if res := <-alice; !errors.Is(res.err, lobby.ErrTimedOut) {
+			t.Errorf("want error %v, got %v", lobby.ErrTimedOut, res.err)
+		}
+		if n := l.Waiting(preset); n != 0 {
+			t.Errorf("want empty queue, got %d waiting", n)
+		}
+	})
+
+	t.Run("cancelling ctx leaves the queue", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		l, _, clock := newLobby()
+		preset := lobby.Preset{Board: board, Mode: lobby.ModeVersus, Players: 2}
+
+		alice := queue(ctx, l, "alice", preset)
+		cancel()
+
+		if res := <-alice; !errors.Is(res.err, context.Canceled) {
+			t.Errorf("want error %v, got %v", context.Canceled, res.err)
+		}
+		if n := l.Waiting(preset); n != 0 {
+			t.Errorf("want empty queue, got %d waiting", n)
+		}
+		if n := clock.Timers(); n != 0 {
+			t.Errorf("want timeout to be stopped, got %d timers", n)
+		}
+	})
+
+	t.Run("players may only queue once", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		l, _, _ := newLobby()
+		preset := lobby.Preset{Board: board, Mode: lobby.ModeVersus, Players: 2}
+
+		_ = queue(ctx, l, "alice", preset)
+		if _, err := l.Queue(ctx, "alice", preset); !errors.Is(err, lobby.ErrAlreadyQueued) {
+			t.Errorf("want error %v, got %v", lobby.ErrAlreadyQueued, err)
+		}
+	})
+}
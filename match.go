@@ -0,0 +1,116 @@
+package sweeper
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// A Match groups the Games of players competing on identical Boards. The first
+// player to win their Game, or the last player not to lose theirs, wins the
+// Match.
+type Match struct {
+	ID    uuid.UUID
+	Board Board
+	Seed  uint64 // Seed generated every Game in the Match.
+
+	Players []MatchPlayer
+	// Results are the final states of the players' Games, in the order they
+	// finished.
+	Results []MatchResult
+	// Winner is the player that won the Match. It is empty until the Match is
+	// decided, and remains so if every player lost.
+	Winner PlayerID
+}
+
+// A MatchPlayer is a player competing in a Match, and the Game they play in it.
+type MatchPlayer struct {
+	Player PlayerID
+	GameID uuid.UUID
+}
+
+// A MatchResult records how a player's Game in a Match ended.
+type MatchResult struct {
+	Player PlayerID
+	State  GameState
+}
+
+// Progress summarises how far a player has got through their Game, without
+// revealing anything about where its mines are.
+type Progress struct {
+	Player        PlayerID
+	GameID        uuid.UUID
+	State         GameState
+	CellsRevealed int
+	FlagsPlaced   int
+}
+
+// Clone returns a deep copy of the Match.
+func (m *Match) Clone() *Match {
+	res := *m
+	res.Players = slices.Clone(m.Players)
+	res.Results = slices.Clone(m.Results)
+	return &res
+}
+
+// Finished reports whether the Match has been decided, or every player's Game
+// has finished.
+func (m *Match) Finished() bool {
+	return m.Winner != "" || len(m.Results) == len(m.Players)
+}
+
+func (m *Match) finishedPlaying(p PlayerID) bool {
+	return slices.ContainsFunc(m.Results, func(r MatchResult) bool { return r.Player == p })
+}
+
+// record notes that the player's Game finished in the GameState, deciding the
+// Match if it can be.
+func (m *Match) record(p PlayerID, s GameState) {
+	if m.finishedPlaying(p) {
+		return
+	}
+	m.Results = append(m.Results, MatchResult{Player: p, State: s})
+
+	switch {
+	case m.Winner != "":
+		return
+	case s == GameWon:
+		m.Winner = p
+		return
+	}
+
+	var stillPlaying []PlayerID
+	for _, mp := range m.Players {
+		if !m.finishedPlaying(mp.Player) {
+			stillPlaying = append(stillPlaying, mp.Player)
+		}
+	}
+	if len(stillPlaying) == 1 {
+		m.Winner = stillPlaying[0]
+	}
+}
+
+// Progress summarises the Game for its opponents.
+func (g *Game) Progress() Progress {
+	p := Progress{GameID: g.ID, State: g.State}
+	if len(g.Players) > 0 {
+		p.Player = g.Players[0].ID
+	}
+
+	for _, c := range g.Cells.cells {
+		switch c.State {
+		case CellRevealed:
+			p.CellsRevealed++
+		case CellFlagged:
+			p.FlagsPlaced += c.Flags
+		}
+	}
+	return p
+}
+
+// SeededNumberGenerator returns a NumberGenerator that always generates the
+// same sequence of numbers for the same seed.
+func SeededNumberGenerator(seed uint64) NumberGenerator {
+	return rand.New(rand.NewPCG(seed, seed)).IntN
+}
@@ -0,0 +1,262 @@
+package sweeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// A PlayerID identifies a player.
+type PlayerID string
+
+type callerKey struct{}
+
+// WithCaller returns a copy of ctx recording that it carries a request from
+// the player, who has been authenticated. The Service then only lets the
+// request see Games and Matches as that player.
+func WithCaller(ctx context.Context, player PlayerID) context.Context {
+	return context.WithValue(ctx, callerKey{}, player)
+}
+
+// Caller returns the authenticated player whose request ctx carries, if any.
+func Caller(ctx context.Context) (PlayerID, bool) {
+	player, ok := ctx.Value(callerKey{}).(PlayerID)
+	return player, ok
+}
+
+// viewer returns the player asking to see a Game or Match. If ctx carries an
+// authenticated caller, it is them, and they may only ask as themselves.
+// Otherwise the player is trusted.
+func viewer(ctx context.Context, player PlayerID) (PlayerID, error) {
+	caller, ok := Caller(ctx)
+	switch {
+	case !ok:
+		return player, nil
+	case player != "" && player != caller:
+		return "", ErrNotAPlayer
+	}
+	return caller, nil
+}
+
+// A Player is taking part in a Game.
+type Player struct {
+	ID          PlayerID
+	CellsOpened int // The number of safe Cells the Player has revealed.
+	Score       int // The number of mines the Player has claimed under RulesFlags.
+}
+
+// A Move is a change made to the CellState of a Cell by a player.
+type Move struct {
+	Player PlayerID
+	Ref    CellRef
+	State  CellState
+	At     time.Time
+
+	// Opened is the number of safe Cells revealed by the Move. It is set when the
+	// Move is applied.
+	Opened int
+}
+
+// player returns the index of the Player with the PlayerID in Game.Players,
+// or -1 if they are not taking part.
+func (g *Game) player(id PlayerID) int {
+	return slices.IndexFunc(g.Players, func(p Player) bool { return p.ID == id })
+}
+
+// CanMove reports whether the player may make moves in the Game. If the Game
+// has no Players, anyone may.
+func (g *Game) CanMove(id PlayerID) bool {
+	return len(g.Players) == 0 || g.player(id) >= 0
+}
+
+// Apply applies the Move using UpdateCell, recording it in Game.Moves and
+// crediting the player with any Cells it opened.
+//
+// Under RulesFlags, only the Player whose turn it is may move, and revealing a
+// Cell either claims the mines in it or passes the turn on.
+func (g *Game) Apply(m Move) error {
+	if !g.CanMove(m.Player) {
+		return ErrNotAPlayer
+	}
+
+	i := g.player(m.Player)
+	if g.Board.Rules == RulesFlags && i >= 0 && i != g.Turn {
+		return ErrNotYourTurn
+	}
+
+	opened, err := g.updateCell(m.Ref, m.State)
+	if err != nil {
+		return err
+	}
+
+	m.Opened = opened
+	g.Moves = append(g.Moves, m)
+	if i >= 0 {
+		g.Players[i].CellsOpened += opened
+	}
+	if g.Board.Rules == RulesFlags && m.State == CellRevealed {
+		g.takeTurn(i, g.Cells.At(m.Ref).Mines)
+	}
+	return nil
+}
+
+// A CellMove is one of the moves made together by Service.MakeMoves.
+type CellMove struct {
+	Ref   CellRef
+	State CellState
+	Chord bool // If set, the Cell is chorded instead of having its State changed.
+}
+
+// A MoveError reports which of the moves made together failed.
+type MoveError struct {
+	Index int
+	Err   error
+}
+
+func (e *MoveError) Error() string { return fmt.Sprintf("move %d: %v", e.Index, e.Err) }
+
+func (e *MoveError) Unwrap() error { return e.Err }
+
+// Chord reveals every unflagged Cell around the revealed Cell at ref, as long
+// as as many flags surround it as it has neighbouring mines. Otherwise it does
+// nothing. Each Cell revealed is applied as a Move by the player at the time.
+//
+// Under RulesFlags, chording stops as soon as the turn passes on.
+func (g *Game) Chord(player PlayerID, ref CellRef, at time.Time) error {
+	switch {
+	case !g.CanMove(player):
+		return ErrNotAPlayer
+	case g.finished():
+		return ErrGameFinished
+	case !g.checkBounds(ref):
+		return ErrOutOfBounds
+	}
+
+	c := g.Cells.At(ref)
+	if c.State != CellRevealed || c.ContainsMine() {
+		return nil
+	}
+
+	neighbours := g.Board.Neighbours(ref)
+	var flags int
+	for _, n := range neighbours {
+		if nc := g.Cells.At(n); nc.State == CellFlagged {
+			flags += nc.Flags
+		}
+	}
+	if flags != c.NeighbouringMines {
+		return nil
+	}
+
+	turn := g.Turn
+	for _, n := range neighbours {
+		if g.finished() || g.Turn != turn {
+			break
+		}
+		// earlier reveals may have flooded into this cell already.
+		if s := g.Cells.At(n).State; s == CellRevealed || s == CellFlagged {
+			continue
+		}
+		if err := g.Apply(Move{Player: player, Ref: n, State: CellRevealed, At: at}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// takeTurn credits the Player at index i in Players with any mines they
+// claimed this turn, passing the turn on if they didn't claim any. Games
+// without Players have nobody to credit, so i is negative. It ends the Game
+// once the outcome is decided: a draw if the leaders are tied once every mine
+// is claimed.
+func (g *Game) takeTurn(i int, claimed int) {
+	if i >= 0 {
+		if claimed == 0 {
+			g.Turn = (g.Turn + 1) % len(g.Players)
+			return
+		}
+		g.Players[i].Score += claimed
+	}
+
+	var (
+		best    int
+		leaders []PlayerID
+	)
+	for _, p := range g.Players {
+		switch {
+		case p.Score > best:
+			best, leaders = p.Score, []PlayerID{p.ID}
+		case p.Score == best:
+			leaders = append(leaders, p.ID)
+		}
+	}
+
+	switch {
+	case i >= 0 && best*2 > g.Board.Mines:
+		// nobody else can catch up
+		g.State, g.Winner = GameWon, g.Players[i].ID
+	case g.claimedMines() < g.Board.Mines:
+		// there are mines left to claim
+	case len(leaders) > 1:
+		g.State = GameDrawn
+	default:
+		g.State = GameWon
+		if len(leaders) == 1 {
+			g.Winner = leaders[0]
+		}
+	}
+}
+
+// claimedMines returns the number of mines in revealed Cells.
+func (g *Game) claimedMines() (n int) {
+	for _, c := range g.Cells.cells {
+		if c.State == CellRevealed {
+			n += c.Mines
+		}
+	}
+	return n
+}
+
+// Invite allows the invitee to join the Game. Only Players may invite others,
+// and only to Games outside of Matches, so nobody can help their opponents.
+func (g *Game) Invite(inviter, invitee PlayerID) error {
+	switch {
+	case g.finished():
+		return ErrGameFinished
+	case g.MatchID != uuid.Nil:
+		return ErrInMatch
+	case g.player(inviter) < 0:
+		return ErrNotAPlayer
+	case g.player(invitee) >= 0:
+		return ErrJoined
+	}
+
+	if !slices.Contains(g.Invited, invitee) {
+		g.Invited = append(g.Invited, invitee)
+	}
+	return nil
+}
+
+// Join adds the invited player to the Game's Players. Games in Matches can't
+// be joined.
+func (g *Game) Join(id PlayerID) error {
+	switch {
+	case g.finished():
+		return ErrGameFinished
+	case g.MatchID != uuid.Nil:
+		return ErrInMatch
+	case g.player(id) >= 0:
+		return ErrJoined
+	}
+
+	i := slices.Index(g.Invited, id)
+	if i < 0 {
+		return ErrNotInvited
+	}
+	g.Invited = slices.Delete(g.Invited, i, i+1)
+	g.Players = append(g.Players, Player{ID: id})
+	return nil
+}
@@ -0,0 +1,56 @@
+package sweeper
+
+import "fmt"
+
+// MaxRegionCells is the most Cells a Region may hold.
+const MaxRegionCells = 256 * 256
+
+// A Region is a rectangle of Cells, Rows tall and Columns wide, with its top
+// left Cell at {Top, Left}.
+type Region struct {
+	Top, Left     int
+	Rows, Columns int
+}
+
+// Len returns the number of Cells in the Region.
+func (r Region) Len() int { return r.Rows * r.Columns }
+
+// Ref returns the i'th Cell in the Region, counting along each row.
+func (r Region) Ref(i int) CellRef {
+	return CellRef{Row: r.Top + i/r.Columns, Column: r.Left + i%r.Columns}
+}
+
+// Contains reports whether the Cell at ref is in the Region.
+func (r Region) Contains(ref CellRef) bool {
+	return r.Top <= ref.Row && ref.Row < r.Top+r.Rows &&
+		r.Left <= ref.Column && ref.Column < r.Left+r.Columns
+}
+
+// Validate checks the Region holds at least one Cell, and no more than
+// MaxRegionCells.
+func (r Region) Validate() error {
+	switch {
+	case r.Rows <= 0 || r.Columns <= 0:
+		return fmt.Errorf("%w: invalid region dimensions (%dx%d)", ErrOutOfBounds, r.Rows, r.Columns)
+	case r.Rows > MaxRegionCells/r.Columns:
+		return fmt.Errorf("%w: regions may hold at most %d cells", ErrOutOfBounds, MaxRegionCells)
+	}
+	return nil
+}
+
+// Within returns the part of the Region on the Board, which holds no Cells if
+// none of it is. Regions of Boards cover every layer.
+func (r Region) Within(b Board) Region {
+	var (
+		top    = max(r.Top, 0)
+		left   = max(r.Left, 0)
+		bottom = min(r.Top+r.Rows, b.Height)
+		right  = min(r.Left+r.Columns, b.Width)
+	)
+	return Region{
+		Top:     top,
+		Left:    left,
+		Rows:    max(bottom-top, 0),
+		Columns: max(right-left, 0),
+	}
+}
@@ -0,0 +1,52 @@
+package sweeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nightmarlin/sweeper"
+)
+
+func TestRegion_Within(t *testing.T) {
+	t.Parallel()
+
+	board := sweeper.Board{Height: 4, Width: 5}
+	tests := []struct {
+		name   string
+		region sweeper.Region
+		want   sweeper.Region
+	}{
+		{
+			name:   "inside",
+			region: sweeper.Region{Top: 1, Left: 1, Rows: 2, Columns: 3},
+			want:   sweeper.Region{Top: 1, Left: 1, Rows: 2, Columns: 3},
+		},
+		{
+			name:   "overlapping",
+			region: sweeper.Region{Top: -1, Left: 3, Rows: 3, Columns: 10},
+			want:   sweeper.Region{Top: 0, Left: 3, Rows: 2, Columns: 2},
+		},
+		{
+			name:   "outside",
+			region: sweeper.Region{Top: 4, Left: 0, Rows: 2, Columns: 2},
+			want:   sweeper.Region{Top: 4, Left: 0, Rows: 0, Columns: 2},
+		},
+	}
+	for _, tt := range tests {
+		got := tt.region.Within(board)
+		if got != tt.want {
+			t.Errorf("%s: want %+v, got %+v", tt.name, tt.want, got)
+		}
+		for i := range got.Len() {
+			if !board.Contains(got.Ref(i)) {
+				t.Errorf("%s: %v is not on the board", tt.name, got.Ref(i))
+			}
+		}
+	}
+
+	for _, r := range []sweeper.Region{{Rows: 0, Columns: 1}, {Rows: 1, Columns: sweeper.MaxRegionCells + 1}} {
+		if err := r.Validate(); !errors.Is(err, sweeper.ErrOutOfBounds) {
+			t.Errorf("%+v: want %v, got %v", r, sweeper.ErrOutOfBounds, err)
+		}
+	}
+}
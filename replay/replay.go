@@ -0,0 +1,418 @@
+// Package replay converts Games to and from the RAW video format (RawVF) used
+// by minesweeper video sites, so runs can be uploaded, and replays can be
+// checked against the seeded boards they claim to be played on.
+//
+// Only the parts of RawVF needed to replay a game are read and written:
+//
+//	RawVF_Version: Rev5
+//	Program: sweeper
+//	Player: alice
+//	Timestamp: 2024-01-01T00:00:00Z
+//	Level: Custom
+//	Width: 4
+//	Height: 3
+//	Mines: 2
+//	Marks: On
+//	Time: 1.25
+//	Generator: 2
+//	Board:
+//	*000
+//	00*0
+//	0000
+//	Events:
+//	0.00 start
+//	0.00 lc 4 3 (56 40)
+//	0.00 lr 4 3 (56 40)
+//	1.25 rc 1 1 (8 8)
+//	1.25 rr 1 1 (8 8)
+//
+// Event coordinates are a 1-based column and row, followed by the position of
+// the cursor in pixels on a board of 16 pixel squares. Cells are revealed when
+// the left button is released, and right clicks cycle a cell between being
+// unmarked, flagged and questioned. Generator is the sweeper.GeneratorVersion
+// the board was generated by, which replays written before it was recorded
+// lack. Other headers and events are ignored when reading.
+package replay
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+)
+
+type Action string
+
+const (
+	ActionStart        = Action("start")
+	ActionLeftClick    = Action("lc")
+	ActionLeftRelease  = Action("lr")
+	ActionRightClick   = Action("rc")
+	ActionRightRelease = Action("rr")
+)
+
+type Event struct {
+	At     time.Duration // Since the game started.
+	Action Action
+	Ref    sweeper.CellRef // Unused by ActionStart.
+}
+
+type Replay struct {
+	Player    sweeper.PlayerID
+	Timestamp time.Time
+	Board     sweeper.Board // Only the Width, Height and Mines are set.
+	Mines     []sweeper.CellRef
+	Time      time.Duration // How long the game was played for.
+	Events    []Event
+
+	// Generator is the sweeper.GeneratorVersion the Board was generated by.
+	// Replays that don't record it were generated by version 1.
+	Generator int
+}
+
+// marks are the states right clicks cycle a Cell through.
+var marks = []sweeper.CellState{sweeper.CellDefault, sweeper.CellFlagged, sweeper.CellQuestioned}
+
+// FromGame records the finished Game as a Replay. Only flat square boards
+// with at most one mine per cell and no wrapping can be recorded.
+func FromGame(g *sweeper.Game) (*Replay, error) {
+	switch b := g.Board; {
+	case b.Topology != sweeper.TopologySquare, b.Depth > 1, b.Wrap, b.MaxMinesPerCell > 1:
+		return nil, ErrUnsupported
+	case g.State == sweeper.GameOngoing:
+		return nil, ErrUnfinished
+	}
+
+	r := &Replay{
+		Timestamp: g.StartedAt.UTC(),
+		Board:     sweeper.Board{Width: g.Board.Width, Height: g.Board.Height, Mines: g.Board.Mines},
+		Events:    []Event{{Action: ActionStart}},
+		Generator: sweeper.GeneratorVersion,
+	}
+	if len(g.Players) > 0 {
+		r.Player = g.Players[0].ID
+	}
+	for row := range g.Board.Height {
+		for col := range g.Board.Width {
+			if ref := (sweeper.CellRef{Row: row, Column: col}); g.Cells.At(ref).ContainsMine() {
+				r.Mines = append(r.Mines, ref)
+			}
+		}
+	}
+
+	if g.Opening != nil {
+		r.Events = append(r.Events, click(0, ActionLeftClick, ActionLeftRelease, *g.Opening)...)
+	}
+
+	marked := make(map[sweeper.CellRef]sweeper.CellState)
+	for _, m := range g.Moves {
+		at := m.At.Sub(g.StartedAt)
+		r.Time = at
+
+		if m.State == sweeper.CellRevealed {
+			r.Events = append(r.Events, click(at, ActionLeftClick, ActionLeftRelease, m.Ref)...)
+			continue
+		}
+
+		from, to := slices.Index(marks, marked[m.Ref]), slices.Index(marks, m.State)
+		for range (to - from + len(marks)) % len(marks) {
+			r.Events = append(r.Events, click(at, ActionRightClick, ActionRightRelease, m.Ref)...)
+		}
+		marked[m.Ref] = m.State
+	}
+	return r, nil
+}
+
+func click(at time.Duration, press, release Action, ref sweeper.CellRef) []Event {
+	return []Event{{At: at, Action: press, Ref: ref}, {At: at, Action: release, Ref: ref}}
+}
+
+// Verify replays the Replay on the Board generated from the seed, returning
+// the resulting Game. It fails with ErrMismatch if the Replay's mines aren't
+// where the seed puts them, and ErrGenerator if the Board was generated by
+// another version of the generator, so the seed can't be checked.
+func (r *Replay) Verify(ctx context.Context, seed uint64) (*sweeper.Game, error) {
+	if r.Generator != sweeper.GeneratorVersion {
+		return nil, fmt.Errorf(
+			"%w: board was generated by version %d, want %d", ErrGenerator, r.Generator, sweeper.GeneratorVersion,
+		)
+	}
+
+	g, err := sweeper.NewGame(ctx, uuid.New, sweeper.SeededNumberGenerator(seed), r.Board)
+	if err != nil {
+		return nil, fmt.Errorf("generating board: %w", err)
+	}
+	g.StartedAt = r.Timestamp
+
+	var mines []sweeper.CellRef
+	g.Cells.Each(func(ref sweeper.CellRef, c sweeper.Cell) {
+		if c.ContainsMine() {
+			mines = append(mines, ref)
+		}
+	})
+	cmp := func(a, b sweeper.CellRef) int {
+		if a.Row != b.Row {
+			return a.Row - b.Row
+		}
+		return a.Column - b.Column
+	}
+	want := slices.Clone(r.Mines)
+	slices.SortFunc(mines, cmp)
+	slices.SortFunc(want, cmp)
+	if !slices.Equal(mines, want) {
+		return nil, fmt.Errorf("%w: mines are not where the seed puts them", ErrMismatch)
+	}
+
+	for i, e := range r.Events {
+		if g.State != sweeper.GameOngoing {
+			break
+		}
+
+		c := g.Cells.At(e.Ref)
+		var to sweeper.CellState
+		switch {
+		case c.State == sweeper.CellRevealed:
+			continue // clicking revealed cells does nothing
+		case e.Action == ActionLeftRelease && c.State != sweeper.CellFlagged:
+			to = sweeper.CellRevealed
+		case e.Action == ActionRightClick:
+			to = marks[(slices.Index(marks, c.State)+1)%len(marks)]
+		default:
+			continue
+		}
+
+		if err := g.Apply(sweeper.Move{Ref: e.Ref, State: to, At: g.StartedAt.Add(e.At)}); err != nil {
+			return nil, fmt.Errorf("%w: event %d: %w", ErrMismatch, i+1, err)
+		}
+	}
+	return g, nil
+}
+
+// Encode writes the Replay to w.
+func Encode(w io.Writer, r *Replay) error {
+	bw := bufio.NewWriter(w)
+
+	level := "Custom"
+	switch b := r.Board; {
+	case b.Width == 8 && b.Height == 8 && b.Mines == 10:
+		level = "Beginner"
+	case b.Width == 16 && b.Height == 16 && b.Mines == 40:
+		level = "Intermediate"
+	case b.Width == 30 && b.Height == 16 && b.Mines == 99:
+		level = "Expert"
+	}
+
+	for _, h := range [][2]string{
+		{"RawVF_Version", "Rev5"},
+		{"Program", "sweeper"},
+		{"Player", string(r.Player)},
+		{"Timestamp", r.Timestamp.Format(time.RFC3339)},
+		{"Level", level},
+		{"Width", strconv.Itoa(r.Board.Width)},
+		{"Height", strconv.Itoa(r.Board.Height)},
+		{"Mines", strconv.Itoa(r.Board.Mines)},
+		{"Marks", "On"},
+		{"Time", seconds(r.Time)},
+		{"Generator", strconv.Itoa(r.Generator)},
+	} {
+		_, _ = fmt.Fprintf(bw, "%s: %s\n", h[0], h[1])
+	}
+
+	_, _ = fmt.Fprintln(bw, "Board:")
+	for row := range r.Board.Height {
+		line := []byte(strings.Repeat("0", r.Board.Width))
+		for _, m := range r.Mines {
+			if m.Row == row {
+				line[m.Column] = '*'
+			}
+		}
+		_, _ = fmt.Fprintf(bw, "%s\n", line)
+	}
+
+	_, _ = fmt.Fprintln(bw, "Events:")
+	for _, e := range r.Events {
+		if e.Action == ActionStart {
+			_, _ = fmt.Fprintf(bw, "%s %s\n", seconds(e.At), e.Action)
+			continue
+		}
+		_, _ = fmt.Fprintf(
+			bw, "%s %s %d %d (%d %d)\n",
+			seconds(e.At), e.Action, e.Ref.Column+1, e.Ref.Row+1,
+			e.Ref.Column*squarePixels+squarePixels/2, e.Ref.Row*squarePixels+squarePixels/2,
+		)
+	}
+	return bw.Flush()
+}
+
+const squarePixels = 16
+
+func seconds(d time.Duration) string { return strconv.FormatFloat(d.Seconds(), 'f', 2, 64) }
+
+func parseSeconds(s string) (time.Duration, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f < 0 || math.IsInf(f, 0) || f > math.MaxInt64/float64(time.Second) {
+		return 0, fmt.Errorf("%w: invalid time %q", ErrInvalidReplay, s)
+	}
+	return time.Duration(math.Round(f*100)) * 10 * time.Millisecond, nil
+}
+
+// Decode reads a Replay from r.
+func Decode(r io.Reader) (*Replay, error) {
+	var (
+		rep     = Replay{Generator: 1}
+		s       = bufio.NewScanner(r)
+		line    int
+		section = "header"
+		row     int
+	)
+
+	for s.Scan() {
+		line++
+		text := strings.TrimSpace(s.Text())
+		if line == 1 && !strings.HasPrefix(text, "RawVF_Version:") {
+			return nil, fmt.Errorf("%w: not a RawVF file", ErrInvalidReplay)
+		}
+		if text == "" {
+			continue
+		}
+
+		switch {
+		case text == "Board:":
+			if rep.Board.Width <= 0 || rep.Board.Height <= 0 || rep.Board.Width*rep.Board.Height > maxCells {
+				return nil, fmt.Errorf("%w: invalid board dimensions", ErrInvalidReplay)
+			}
+			section = "board"
+			continue
+
+		case text == "Events:":
+			if row != rep.Board.Height {
+				return nil, fmt.Errorf("%w: want %d board rows, got %d", ErrInvalidReplay, rep.Board.Height, row)
+			}
+			section = "events"
+			continue
+		}
+
+		var err error
+		switch section {
+		case "header":
+			err = rep.decodeHeader(text)
+		case "board":
+			err = rep.decodeRow(text, row)
+			row++
+		case "events":
+			err = rep.decodeEvent(text)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case section != "events":
+		return nil, fmt.Errorf("%w: missing events", ErrInvalidReplay)
+	case len(rep.Mines) != rep.Board.Mines:
+		return nil, fmt.Errorf(
+			"%w: board holds %d mines, want %d", ErrInvalidReplay, len(rep.Mines), rep.Board.Mines,
+		)
+	}
+	return &rep, nil
+}
+
+const maxCells = 1 << 20
+
+func (r *Replay) decodeHeader(text string) error {
+	key, value, ok := strings.Cut(text, ":")
+	if !ok {
+		return fmt.Errorf("%w: malformed header %q", ErrInvalidReplay, text)
+	}
+	value = strings.TrimSpace(value)
+
+	var err error
+	switch key {
+	case "Player":
+		r.Player = sweeper.PlayerID(value)
+	case "Timestamp":
+		r.Timestamp, err = time.Parse(time.RFC3339, value)
+	case "Width":
+		r.Board.Width, err = strconv.Atoi(value)
+	case "Height":
+		r.Board.Height, err = strconv.Atoi(value)
+	case "Mines":
+		r.Board.Mines, err = strconv.Atoi(value)
+	case "Time":
+		r.Time, err = parseSeconds(value)
+	case "Generator":
+		r.Generator, err = strconv.Atoi(value)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: invalid %s: %w", ErrInvalidReplay, key, err)
+	}
+	return nil
+}
+
+func (r *Replay) decodeRow(text string, row int) error {
+	if row >= r.Board.Height || len(text) != r.Board.Width {
+		return fmt.Errorf("%w: board doesn't match its dimensions", ErrInvalidReplay)
+	}
+	for col, c := range []byte(text) {
+		if c == '*' {
+			r.Mines = append(r.Mines, sweeper.CellRef{Row: row, Column: col})
+		}
+	}
+	return nil
+}
+
+func (r *Replay) decodeEvent(text string) error {
+	fields := strings.Fields(text)
+	if len(fields) < 2 {
+		return fmt.Errorf("%w: malformed event %q", ErrInvalidReplay, text)
+	}
+
+	at, err := parseSeconds(fields[0])
+	if err != nil {
+		return err
+	}
+
+	e := Event{At: at, Action: Action(fields[1])}
+	switch e.Action {
+	case ActionStart:
+		r.Events = append(r.Events, e)
+		return nil
+	case ActionLeftClick, ActionLeftRelease, ActionRightClick, ActionRightRelease:
+	default:
+		return nil // mouse movement, and other events that don't change the board
+	}
+
+	if len(fields) < 4 {
+		return fmt.Errorf("%w: malformed event %q", ErrInvalidReplay, text)
+	}
+	col, colErr := strconv.Atoi(fields[2])
+	row, rowErr := strconv.Atoi(fields[3])
+	e.Ref = sweeper.CellRef{Row: row - 1, Column: col - 1}
+	if colErr != nil || rowErr != nil || !r.Board.Contains(e.Ref) {
+		return fmt.Errorf("%w: event %q is not on the board", ErrInvalidReplay, text)
+	}
+
+	r.Events = append(r.Events, e)
+	return nil
+}
+
+var (
+	ErrInvalidReplay = fmt.Errorf("invalid replay")
+	ErrMismatch      = fmt.Errorf("replay does not match its board")
+	ErrGenerator     = fmt.Errorf("replay was generated by another board generator")
+	ErrUnsupported   = fmt.Errorf("game can't be recorded as a replay")
+	ErrUnfinished    = fmt.Errorf("game is not finished")
+)
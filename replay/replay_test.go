@@ -0,0 +1,163 @@
+package replay_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+	"github.com/nightmarlin/sweeper/replay"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+const seed = 1
+
+var golden = filepath.Join("testdata", "beginner.rawvf")
+
+// playGame plays a beginner Game generated from seed, flagging a mine and
+// then revealing every safe Cell in turn.
+func playGame(t *testing.T) *sweeper.Game {
+	t.Helper()
+
+	g, err := sweeper.NewGame(
+		context.Background(),
+		uuid.New,
+		sweeper.SeededNumberGenerator(seed),
+		sweeper.Board{Width: 8, Height: 8, Mines: 10},
+	)
+	if err != nil {
+		t.Fatalf("creating game: %v", err)
+	}
+	g.StartedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	g.Players = []sweeper.Player{{ID: "alice"}}
+
+	at := g.StartedAt
+	move := func(ref sweeper.CellRef, s sweeper.CellState) {
+		t.Helper()
+		at = at.Add(250 * time.Millisecond)
+		if err := g.Apply(sweeper.Move{Player: "alice", Ref: ref, State: s, At: at}); err != nil {
+			t.Fatalf("moving %v to %v: %v", ref, s, err)
+		}
+	}
+
+	for row := range 8 {
+		for col := range 8 {
+			ref := sweeper.CellRef{Row: row, Column: col}
+			switch c := g.Cells.At(ref); {
+			case g.State != sweeper.GameOngoing:
+				return g
+			case c.ContainsMine() && row == 0:
+				// cycle the mine through every mark
+				move(ref, sweeper.CellFlagged)
+				move(ref, sweeper.CellQuestioned)
+				move(ref, sweeper.CellFlagged)
+			case !c.ContainsMine() && c.State != sweeper.CellRevealed:
+				move(ref, sweeper.CellRevealed)
+			}
+		}
+	}
+	return g
+}
+
+func TestEncode_golden(t *testing.T) {
+	t.Parallel()
+
+	g := playGame(t)
+	if g.State != sweeper.GameWon {
+		t.Fatalf("want game to be won, got %v", g.State)
+	}
+
+	r, err := replay.FromGame(g)
+	if err != nil {
+		t.Fatalf("recording replay: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := replay.Encode(&buf, r); err != nil {
+		t.Fatalf("encoding: %v", err)
+	}
+
+	if *update {
+		if err := os.WriteFile(golden, buf.Bytes(), 0o644); err != nil {
+			t.Fatalf("updating golden file: %v", err)
+		}
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("reading golden file: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("want\n%s\ngot\n%s", want, buf.Bytes())
+	}
+}
+
+func TestDecode_golden(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("reading golden file: %v", err)
+	}
+
+	r, err := replay.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decoding: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := replay.Encode(&buf, r); err != nil {
+		t.Fatalf("encoding: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), data) {
+		t.Errorf("round trip changed replay: want\n%s\ngot\n%s", data, buf.Bytes())
+	}
+
+	got, err := r.Verify(context.Background(), seed)
+	if err != nil {
+		t.Fatalf("verifying: %v", err)
+	}
+	want := playGame(t)
+	if got.State != want.State {
+		t.Errorf("want state %v, got %v", want.State, got.State)
+	}
+	want.Cells.Each(func(ref sweeper.CellRef, c sweeper.Cell) {
+		if got.Cells.At(ref).State != c.State {
+			t.Errorf("cell %v: want state %v, got %v", ref, c.State, got.Cells.At(ref).State)
+		}
+	})
+
+	if _, err := r.Verify(context.Background(), seed+1); !errors.Is(err, replay.ErrMismatch) {
+		t.Errorf("verifying against another seed: want error %v, got %v", replay.ErrMismatch, err)
+	}
+}
+
+func TestReplay_Verify_generator(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("reading golden file: %v", err)
+	}
+
+	// replays from before the generator was recorded were generated by version 1.
+	data = bytes.Replace(data, []byte(fmt.Sprintf("Generator: %d\n", sweeper.GeneratorVersion)), nil, 1)
+	r, err := replay.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decoding: %v", err)
+	}
+	if r.Generator != 1 {
+		t.Errorf("want generator 1, got %d", r.Generator)
+	}
+	if _, err := r.Verify(context.Background(), seed); !errors.Is(err, replay.ErrGenerator) {
+		t.Errorf("want error %v, got %v", replay.ErrGenerator, err)
+	}
+}
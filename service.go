@@ -3,12 +3,19 @@ package sweeper
 import (
 	"context"
 	"fmt"
+	"math"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type GameMutator func(ctx context.Context, g *Game) error
 
+type MatchMutator func(ctx context.Context, m *Match) error
+
+type EndlessMutator func(ctx context.Context, e *Endless) error
+
 type Store interface {
 	SaveGame(ctx context.Context, game *Game) error
 	GetGame(ctx context.Context, gameID uuid.UUID) (*Game, error)
@@ -17,56 +24,504 @@ type Store interface {
 		gameID uuid.UUID,
 		mut GameMutator,
 	) (*Game, error)
+
+	SaveMatch(ctx context.Context, match *Match) error
+	GetMatch(ctx context.Context, matchID uuid.UUID) (*Match, error)
+	MutateMatch(
+		ctx context.Context,
+		matchID uuid.UUID,
+		mut MatchMutator,
+	) (*Match, error)
+
+	SaveEndless(ctx context.Context, game *Endless) error
+	GetEndless(ctx context.Context, gameID uuid.UUID) (*Endless, error)
+	MutateEndless(
+		ctx context.Context,
+		gameID uuid.UUID,
+		mut EndlessMutator,
+	) (*Endless, error)
 }
 
 type Service struct {
 	store     Store
 	idGen     IDGenerator
 	numberGen NumberGenerator
+	clock     Clock
+
+	watchers *watchers
 }
 
-func NewService(store Store, idGen IDGenerator, numberGen NumberGenerator) Service {
+func NewService(
+	store Store,
+	idGen IDGenerator,
+	numberGen NumberGenerator,
+	clock Clock,
+) Service {
 	return Service{
 		store:     store,
 		idGen:     idGen,
 		numberGen: numberGen,
+		clock:     clock,
+		watchers:  newWatchers(),
 	}
 }
 
-func (s Service) StartGame(ctx context.Context, board Board) (*Game, error) {
+// Now returns the current time according to the Service's Clock.
+func (s Service) Now() time.Time { return s.clock.Now() }
+
+// StartGame creates a new Game on the Board. If player is set, they become the
+// Game's first Player, and only they and the players they invite may make
+// moves.
+func (s Service) StartGame(ctx context.Context, player PlayerID, board Board) (*Game, error) {
 	g, err := NewGame(ctx, s.idGen, s.numberGen, board)
 	if err != nil {
 		return nil, fmt.Errorf("creating game: %w", err)
 	}
-	if err := s.store.SaveGame(ctx, g); err != nil {
-		return nil, fmt.Errorf("saving game: %w", err)
+	if player != "" {
+		g.Players = []Player{{ID: player}}
+	}
+
+	if err := s.saveGame(ctx, g); err != nil {
+		return nil, err
 	}
 	return g, nil
 }
 
-func (s Service) GetGame(ctx context.Context, gameID uuid.UUID) (*Game, error) {
-	return s.store.GetGame(ctx, gameID)
+// StartGameFromLayout creates a new Game on the Board with its mines placed as
+// in the Layout. The player is treated as in StartGame.
+func (s Service) StartGameFromLayout(
+	ctx context.Context,
+	player PlayerID,
+	board Board,
+	layout Layout,
+) (*Game, error) {
+	g, err := NewGameFromLayout(s.idGen, board, layout)
+	if err != nil {
+		return nil, fmt.Errorf("creating game: %w", err)
+	}
+	if player != "" {
+		g.Players = []Player{{ID: player}}
+	}
+
+	if err := s.saveGame(ctx, g); err != nil {
+		return nil, err
+	}
+	return g, nil
 }
 
-func (s Service) EndGame(ctx context.Context, gameID uuid.UUID) (*Game, error) {
+// saveGame saves a newly started Game, scheduling it to time out if it has a
+// time limit.
+func (s Service) saveGame(ctx context.Context, g *Game) error {
+	g.StartedAt = s.clock.Now()
+	if err := s.store.SaveGame(ctx, g); err != nil {
+		return fmt.Errorf("saving game: %w", err)
+	}
+	s.watchers.publish(g, g.StartedAt)
+
+	if g.Board.TimeLimit > 0 {
+		// time the game out at its deadline, even if the player never moves again.
+		id := g.ID
+		s.clock.AfterFunc(g.Board.TimeLimit, func() {
+			_, _ = s.ExpireGame(context.Background(), id)
+		})
+	}
+	return nil
+}
+
+// mutateGame applies mut to the Game in the Store, telling its spectators
+// about the change. They are told while the Game is still being mutated, so
+// they see changes in the order they were made.
+func (s Service) mutateGame(ctx context.Context, gameID uuid.UUID, mut GameMutator) (*Game, error) {
 	return s.store.MutateGame(
 		ctx,
 		gameID,
-		func(ctx context.Context, g *Game) error { return g.End() },
+		func(ctx context.Context, g *Game) error {
+			if err := mut(ctx, g); err != nil {
+				return err
+			}
+			s.watchers.publish(g, s.clock.Now())
+			return nil
+		},
+	)
+}
+
+// GetGame returns the Game, timing it out first if its deadline has passed.
+func (s Service) GetGame(ctx context.Context, gameID uuid.UUID) (*Game, error) {
+	g, _, err := s.getGame(ctx, gameID)
+	return g, err
+}
+
+// getGame returns the Game, timing it out first if its deadline has passed, so
+// it is never reported as ongoing past it even if the timer set when it started
+// was lost. It reports whether the Game was timed out.
+func (s Service) getGame(ctx context.Context, gameID uuid.UUID) (g *Game, expired bool, err error) {
+	g, err = s.store.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, false, err
+	}
+	deadline, ok := g.Deadline()
+	if !ok || g.finished() || s.clock.Now().Before(deadline) {
+		return g, false, nil
+	}
+	g, err = s.ExpireGame(ctx, gameID)
+	if err != nil {
+		return nil, false, err
+	}
+	return g, true, nil
+}
+
+// ViewGame returns the Game if the player may see it. Anyone may see Games
+// without Players, as anyone may play them. Other Games may only be seen by
+// their Players and invitees until they finish, as everyone else must Spectate
+// them, and Games in a Match until every Game in it has finished, so opponents
+// on the same Board can't learn from each other's.
+//
+// The player is only checked against the caller when ctx carries one (see
+// WithCaller). Without one, whoever asks is trusted to be the player, and the
+// rules above can't be enforced.
+func (s Service) ViewGame(ctx context.Context, gameID uuid.UUID, player PlayerID) (*Game, error) {
+	g, err := s.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.canView(ctx, g, player); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// canView checks the player, or the caller if ctx carries one, may see the
+// Game.
+func (s Service) canView(ctx context.Context, g *Game, player PlayerID) error {
+	player, err := viewer(ctx, player)
+	if err != nil {
+		return err
+	}
+	if len(g.Players) == 0 || g.player(player) >= 0 || slices.Contains(g.Invited, player) {
+		return nil
+	}
+	if !g.finished() {
+		return ErrNotAPlayer
+	}
+	over, err := s.matchOver(ctx, g)
+	if err != nil {
+		return err
+	}
+	if !over {
+		return ErrNotAPlayer
+	}
+	return nil
+}
+
+// matchOver reports whether every Game in the Game's Match has finished. It is
+// always true for Games outside of Matches.
+func (s Service) matchOver(ctx context.Context, g *Game) (bool, error) {
+	if g.MatchID == uuid.Nil {
+		return true, nil
+	}
+	m, err := s.store.GetMatch(ctx, g.MatchID)
+	if err != nil {
+		return false, fmt.Errorf("getting match: %w", err)
+	}
+	return len(m.Results) == len(m.Players), nil
+}
+
+// ExportGame returns the Game if the player may see it, reporting whether the
+// location of its mines may be shared. That is only allowed once it, and every
+// other Game in its Match, has finished, so opponents on the same Board can't
+// learn from it.
+func (s Service) ExportGame(
+	ctx context.Context,
+	gameID uuid.UUID,
+	player PlayerID,
+) (g *Game, complete bool, err error) {
+	g, err = s.ViewGame(ctx, gameID, player)
+	if err != nil {
+		return nil, false, err
+	}
+	if !g.finished() {
+		return g, false, nil
+	}
+	complete, err = s.matchOver(ctx, g)
+	if err != nil {
+		return nil, false, err
+	}
+	return g, complete, nil
+}
+
+// ExpireGame ends the Game with GameTimedOut if its deadline has passed. It is
+// a no-op for untimed Games, or Games that are already finished.
+func (s Service) ExpireGame(ctx context.Context, gameID uuid.UUID) (*Game, error) {
+	g, err := s.mutateGame(
+		ctx,
+		gameID,
+		func(ctx context.Context, g *Game) error {
+			g.Expire(s.clock.Now())
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.settleMatch(ctx, g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (s Service) EndGame(ctx context.Context, gameID uuid.UUID, player PlayerID) (*Game, error) {
+	g, err := s.mutateGame(
+		ctx,
+		gameID,
+		func(ctx context.Context, g *Game) error {
+			if !g.CanMove(player) {
+				return ErrNotAPlayer
+			}
+			if g.Expire(s.clock.Now()) {
+				return nil
+			}
+			return g.End()
+		},
 	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.settleMatch(ctx, g); err != nil {
+		return nil, err
+	}
+	return g, nil
 }
 
+// MakeMove applies a Move on behalf of the player. Moves are serialised by the
+// Store, so any of the Game's Players may make them concurrently.
 func (s Service) MakeMove(
 	ctx context.Context,
 	gameID uuid.UUID,
+	player PlayerID,
 	ref CellRef,
 	toState CellState,
 ) (*Game, error) {
-	return s.store.MutateGame(
+	g, err := s.mutateGame(
 		ctx,
 		gameID,
 		func(ctx context.Context, g *Game) error {
-			return g.UpdateCell(ref, toState)
+			now := s.clock.Now()
+
+			// moves made after the deadline end the game instead of being applied.
+			if g.Expire(now) {
+				return nil
+			}
+			return g.Apply(Move{Player: player, Ref: ref, State: toState, At: now})
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.settleMatch(ctx, g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// MakeMoves applies the moves in order on behalf of the player, returning how
+// many were applied. It stops early, without error, once a move ends the Game.
+//
+// The moves are applied atomically: if one fails, none of them are, and the
+// error is a *MoveError holding the index of the move that failed.
+func (s Service) MakeMoves(
+	ctx context.Context,
+	gameID uuid.UUID,
+	player PlayerID,
+	moves []CellMove,
+) (*Game, int, error) {
+	var applied int
+	g, err := s.mutateGame(
+		ctx,
+		gameID,
+		func(ctx context.Context, g *Game) error {
+			applied = 0
+
+			now := s.clock.Now()
+			if g.Expire(now) {
+				return nil
+			}
+
+			for i, m := range moves {
+				if g.finished() {
+					break
+				}
+
+				var err error
+				if m.Chord {
+					err = g.Chord(player, m.Ref, now)
+				} else {
+					err = g.Apply(Move{Player: player, Ref: m.Ref, State: m.State, At: now})
+				}
+				if err != nil {
+					return &MoveError{Index: i, Err: err}
+				}
+				applied++
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := s.settleMatch(ctx, g); err != nil {
+		return nil, 0, err
+	}
+	return g, applied, nil
+}
+
+// Chord chords the Cell on behalf of the player, as with Game.Chord.
+func (s Service) Chord(ctx context.Context, gameID uuid.UUID, player PlayerID, ref CellRef) (*Game, error) {
+	g, err := s.mutateGame(
+		ctx,
+		gameID,
+		func(ctx context.Context, g *Game) error {
+			now := s.clock.Now()
+			if g.Expire(now) {
+				return nil
+			}
+			return g.Chord(player, ref, now)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.settleMatch(ctx, g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// InvitePlayer allows the invitee to join the Game as a Player. The inviter
+// must already be one of the Game's Players.
+func (s Service) InvitePlayer(
+	ctx context.Context,
+	gameID uuid.UUID,
+	inviter, invitee PlayerID,
+) (*Game, error) {
+	return s.mutateGame(
+		ctx,
+		gameID,
+		func(ctx context.Context, g *Game) error { return g.Invite(inviter, invitee) },
+	)
+}
+
+// JoinGame adds the invited player to the Game's Players.
+func (s Service) JoinGame(ctx context.Context, gameID uuid.UUID, player PlayerID) (*Game, error) {
+	return s.mutateGame(
+		ctx,
+		gameID,
+		func(ctx context.Context, g *Game) error { return g.Join(player) },
+	)
+}
+
+// StartMatch creates a Match between the players, giving each of them their
+// own Game on an identical copy of the Board.
+func (s Service) StartMatch(ctx context.Context, players []PlayerID, board Board) (*Match, error) {
+	if len(players) < 2 {
+		return nil, ErrTooFewPlayers
+	}
+	for i, p := range players {
+		if p == "" || slices.Contains(players[:i], p) {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicatePlayer, p)
+		}
+	}
+
+	m := &Match{
+		ID:    s.idGen(),
+		Board: board,
+		Seed:  uint64(s.numberGen(math.MaxInt)),
+	}
+
+	for _, p := range players {
+		// every game is generated from the same seed, so all players get the same
+		// mines and the same opening.
+		g, err := NewGame(ctx, s.idGen, SeededNumberGenerator(m.Seed), board)
+		if err != nil {
+			return nil, fmt.Errorf("creating game for %s: %w", p, err)
+		}
+		g.Players = []Player{{ID: p}}
+		g.MatchID = m.ID
+
+		m.Players = append(m.Players, MatchPlayer{Player: p, GameID: g.ID})
+		if err := s.saveGame(ctx, g); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.store.SaveMatch(ctx, m); err != nil {
+		return nil, fmt.Errorf("saving match: %w", err)
+	}
+	return m, nil
+}
+
+// GetMatch returns the Match, and the Progress of each of its players.
+func (s Service) GetMatch(ctx context.Context, matchID uuid.UUID) (*Match, []Progress, error) {
+	m, err := s.store.GetMatch(ctx, matchID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		progress = make([]Progress, 0, len(m.Players))
+		settled  bool
+	)
+	for _, mp := range m.Players {
+		g, expired, err := s.getGame(ctx, mp.GameID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("getting game for %s: %w", mp.Player, err)
+		}
+		settled = settled || expired
+		progress = append(progress, g.Progress())
+	}
+
+	if settled {
+		// timing a Game out records its result in the Match.
+		if m, err = s.store.GetMatch(ctx, matchID); err != nil {
+			return nil, nil, err
+		}
+	}
+	return m, progress, nil
+}
+
+// ViewMatch returns the Match, and the Progress of each of its players, if the
+// player may see it. Only the Match's players may see it until it finishes, as
+// everyone else must Spectate its Games. Like ViewGame, the player is only
+// checked against the caller when ctx carries one.
+func (s Service) ViewMatch(ctx context.Context, matchID uuid.UUID, player PlayerID) (*Match, []Progress, error) {
+	player, err := viewer(ctx, player)
+	if err != nil {
+		return nil, nil, err
+	}
+	m, progress, err := s.GetMatch(ctx, matchID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !m.Finished() && !slices.ContainsFunc(m.Players, func(mp MatchPlayer) bool { return mp.Player == player }) {
+		return nil, nil, ErrNotAPlayer
+	}
+	return m, progress, nil
+}
+
+// settleMatch records the result of the Game in its Match once it finishes.
+func (s Service) settleMatch(ctx context.Context, g *Game) error {
+	if g.MatchID == uuid.Nil || !g.finished() || len(g.Players) == 0 {
+		return nil
+	}
+
+	if _, err := s.store.MutateMatch(
+		ctx,
+		g.MatchID,
+		func(ctx context.Context, m *Match) error {
+			m.record(g.Players[0].ID, g.State)
+			return nil
+		},
+	); err != nil {
+		return fmt.Errorf("settling match: %w", err)
+	}
+	return nil
 }
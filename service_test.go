@@ -1 +1,544 @@
 package sweeper_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+	"github.com/nightmarlin/sweeper/infra/memory"
+	"github.com/nightmarlin/sweeper/internal/fakeclock"
+)
+
+func newFakeClock() *fakeclock.Clock {
+	return fakeclock.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+}
+
+// sequence returns a sweeper.NumberGenerator that cycles through ns.
+func sequence(ns ...int) sweeper.NumberGenerator {
+	var i int
+	return func(n int) int {
+		defer func() { i = (i + 1) % len(ns) }()
+		return ns[i] % n
+	}
+}
+
+func TestService_timedGames(t *testing.T) {
+	t.Parallel()
+
+	board := sweeper.Board{Width: 3, Height: 3, Mines: 1, TimeLimit: time.Minute}
+
+	t.Run("move after deadline times out", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		clock := newFakeClock()
+		store := memory.NewStore()
+		svc := sweeper.NewService(store, uuid.New, sequence(1, 0), clock)
+
+		g, err := svc.StartGame(ctx, "", board)
+		if err != nil {
+			t.Fatalf("starting game: %v", err)
+		}
+
+		// move the clock without running the scheduled expiry
+		clock.Set(clock.Now().Add(time.Minute))
+
+		g, err = svc.MakeMove(ctx, g.ID, "", sweeper.CellRef{Row: 0, Column: 0}, sweeper.CellFlagged)
+		if err != nil {
+			t.Fatalf("making move: %v", err)
+		}
+		if g.State != sweeper.GameTimedOut {
+			t.Errorf("want state %v, got %v", sweeper.GameTimedOut, g.State)
+		}
+		if c := g.Cells.At(sweeper.CellRef{Row: 0, Column: 0}); c.State == sweeper.CellFlagged {
+			t.Error("move was applied after deadline")
+		}
+
+		_, err = svc.MakeMove(ctx, g.ID, "", sweeper.CellRef{Row: 0, Column: 0}, sweeper.CellFlagged)
+		if !errors.Is(err, sweeper.ErrGameFinished) {
+			t.Errorf("want %v, got %v", sweeper.ErrGameFinished, err)
+		}
+	})
+
+	t.Run("scheduler times out idle game", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		clock := newFakeClock()
+		svc := sweeper.NewService(memory.NewStore(), uuid.New, sequence(1, 0), clock)
+
+		g, err := svc.StartGame(ctx, "", board)
+		if err != nil {
+			t.Fatalf("starting game: %v", err)
+		}
+
+		clock.Advance(30 * time.Second)
+		if g, _ := svc.GetGame(ctx, g.ID); g.State != sweeper.GameOngoing {
+			t.Fatalf("want state %v before deadline, got %v", sweeper.GameOngoing, g.State)
+		}
+		if rem, ok := g.Remaining(clock.Now()); !ok || rem != 30*time.Second {
+			t.Errorf("want 30s remaining, got %v (%t)", rem, ok)
+		}
+
+		clock.Advance(30 * time.Second)
+		if g, _ := svc.GetGame(ctx, g.ID); g.State != sweeper.GameTimedOut {
+			t.Errorf("want state %v after deadline, got %v", sweeper.GameTimedOut, g.State)
+		}
+	})
+
+	t.Run("reading after deadline times out", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		clock := newFakeClock()
+		store := memory.NewStore()
+		svc := sweeper.NewService(store, uuid.New, sequence(1, 0), clock)
+
+		g, err := svc.StartGame(ctx, "alice", board)
+		if err != nil {
+			t.Fatalf("starting game: %v", err)
+		}
+
+		// move the clock without running the scheduled expiry, as if it was lost.
+		clock.Set(clock.Now().Add(time.Minute))
+
+		for name, get := range map[string]func() (*sweeper.Game, error){
+			"GetGame":  func() (*sweeper.Game, error) { return svc.GetGame(ctx, g.ID) },
+			"ViewGame": func() (*sweeper.Game, error) { return svc.ViewGame(ctx, g.ID, "alice") },
+		} {
+			got, err := get()
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if got.State != sweeper.GameTimedOut {
+				t.Errorf("%s: want state %v, got %v", name, sweeper.GameTimedOut, got.State)
+			}
+		}
+		if stored, _ := store.GetGame(ctx, g.ID); stored.State != sweeper.GameTimedOut {
+			t.Errorf("want stored state %v, got %v", sweeper.GameTimedOut, stored.State)
+		}
+	})
+}
+
+func TestService_coop(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx   = context.Background()
+		clock = newFakeClock()
+		svc   = sweeper.NewService(
+			memory.NewStore(),
+			uuid.New,
+			sequence(1, 0, 2, 4, 6, 2, 1),
+			clock,
+		)
+	)
+
+	// mines in every even column, with the first safe cell already revealed.
+	g, err := svc.StartGame(ctx, "alice", sweeper.Board{Width: 11, Height: 1, Mines: 6})
+	if err != nil {
+		t.Fatalf("starting game: %v", err)
+	}
+
+	if _, err := svc.JoinGame(ctx, g.ID, "bob"); !errors.Is(err, sweeper.ErrNotInvited) {
+		t.Errorf("joining uninvited: want %v, got %v", sweeper.ErrNotInvited, err)
+	}
+	if _, err := svc.InvitePlayer(ctx, g.ID, "bob", "carol"); !errors.Is(err, sweeper.ErrNotAPlayer) {
+		t.Errorf("inviting as non-player: want %v, got %v", sweeper.ErrNotAPlayer, err)
+	}
+	ref := sweeper.CellRef{Row: 0, Column: 0}
+	if _, err := svc.MakeMove(ctx, g.ID, "bob", ref, sweeper.CellFlagged); !errors.Is(err, sweeper.ErrNotAPlayer) {
+		t.Errorf("moving as non-player: want %v, got %v", sweeper.ErrNotAPlayer, err)
+	}
+
+	if _, err := svc.InvitePlayer(ctx, g.ID, "alice", "bob"); err != nil {
+		t.Fatalf("inviting bob: %v", err)
+	}
+	if _, err := svc.JoinGame(ctx, g.ID, "bob"); err != nil {
+		t.Fatalf("joining as bob: %v", err)
+	}
+
+	// alice and bob race to open the remaining safe cells.
+	var wg sync.WaitGroup
+	for i, col := range []int{3, 5, 7, 9} {
+		ref := sweeper.CellRef{Column: col}
+		player := sweeper.PlayerID("alice")
+		if i%2 != 0 {
+			player = "bob"
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := svc.MakeMove(ctx, g.ID, player, ref, sweeper.CellRevealed); err != nil {
+				t.Errorf("revealing %v as %s: %v", ref, player, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	g, err = svc.GetGame(ctx, g.ID)
+	if err != nil {
+		t.Fatalf("getting game: %v", err)
+	}
+	if g.State != sweeper.GameWon {
+		t.Errorf("want state %v, got %v", sweeper.GameWon, g.State)
+	}
+	if len(g.Moves) != 4 {
+		t.Errorf("want 4 moves recorded, got %d", len(g.Moves))
+	}
+	for _, p := range g.Players {
+		var want int
+		for _, m := range g.Moves {
+			if m.Player == p.ID {
+				want += m.Opened
+			}
+		}
+		if p.CellsOpened != want {
+			t.Errorf("%s: want %d cells opened, got %d", p.ID, want, p.CellsOpened)
+		}
+	}
+}
+
+func TestService_versus(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx   = context.Background()
+		board = sweeper.Board{Width: 8, Height: 8, Mines: 10}
+	)
+
+	newMatch := func(t *testing.T, players ...sweeper.PlayerID) (sweeper.Service, *sweeper.Match) {
+		svc := sweeper.NewService(memory.NewStore(), uuid.New, sweeper.SeededNumberGenerator(1), newFakeClock())
+		m, err := svc.StartMatch(ctx, players, board)
+		if err != nil {
+			t.Fatalf("starting match: %v", err)
+		}
+		return svc, m
+	}
+
+	t.Run("boards are mirrored", func(t *testing.T) {
+		t.Parallel()
+
+		svc, m := newMatch(t, "alice", "bob")
+		alice, _ := svc.GetGame(ctx, m.Players[0].GameID)
+		bob, _ := svc.GetGame(ctx, m.Players[1].GameID)
+		if !alice.Cells.Equal(bob.Cells) {
+			t.Error("players were given different boards")
+		}
+		if _, err := svc.MakeMove(ctx, bob.ID, "alice", sweeper.CellRef{}, sweeper.CellFlagged); !errors.Is(err, sweeper.ErrNotAPlayer) {
+			t.Errorf("moving in opponent's game: want %v, got %v", sweeper.ErrNotAPlayer, err)
+		}
+	})
+
+	t.Run("opponents can't see each other's games", func(t *testing.T) {
+		t.Parallel()
+
+		svc, m := newMatch(t, "alice", "bob")
+		alice, bob := m.Players[0].GameID, m.Players[1].GameID
+		if _, err := svc.ViewGame(ctx, alice, "alice"); err != nil {
+			t.Fatalf("viewing own game: %v", err)
+		}
+
+		// alice's game is finished, but bob could still learn the board from it.
+		if _, err := svc.EndGame(ctx, alice, "alice"); err != nil {
+			t.Fatalf("ending alice's game: %v", err)
+		}
+		if _, err := svc.ViewGame(ctx, alice, "bob"); !errors.Is(err, sweeper.ErrNotAPlayer) {
+			t.Errorf("viewing opponent's game: want %v, got %v", sweeper.ErrNotAPlayer, err)
+		}
+		if _, _, err := svc.ExportGame(ctx, alice, "bob"); !errors.Is(err, sweeper.ErrNotAPlayer) {
+			t.Errorf("exporting opponent's game: want %v, got %v", sweeper.ErrNotAPlayer, err)
+		}
+
+		if _, err := svc.EndGame(ctx, bob, "bob"); err != nil {
+			t.Fatalf("ending bob's game: %v", err)
+		}
+		if _, err := svc.ViewGame(ctx, alice, "bob"); err != nil {
+			t.Errorf("viewing opponent's game after the match: %v", err)
+		}
+	})
+
+	t.Run("games can't be shared", func(t *testing.T) {
+		t.Parallel()
+
+		svc, m := newMatch(t, "alice", "bob")
+		alice := m.Players[0].GameID
+		if _, err := svc.InvitePlayer(ctx, alice, "alice", "carol"); !errors.Is(err, sweeper.ErrInMatch) {
+			t.Errorf("inviting a teammate: want %v, got %v", sweeper.ErrInMatch, err)
+		}
+		if _, err := svc.JoinGame(ctx, alice, "carol"); !errors.Is(err, sweeper.ErrInMatch) {
+			t.Errorf("joining: want %v, got %v", sweeper.ErrInMatch, err)
+		}
+		if _, err := svc.ViewGame(ctx, alice, "carol"); !errors.Is(err, sweeper.ErrNotAPlayer) {
+			t.Errorf("viewing as the teammate: want %v, got %v", sweeper.ErrNotAPlayer, err)
+		}
+	})
+
+	t.Run("last player not to lose wins", func(t *testing.T) {
+		t.Parallel()
+
+		svc, m := newMatch(t, "alice", "bob", "carol")
+		for _, mp := range m.Players[:2] {
+			if _, err := svc.EndGame(ctx, mp.GameID, mp.Player); err != nil {
+				t.Fatalf("ending %s's game: %v", mp.Player, err)
+			}
+		}
+
+		m, progress, err := svc.GetMatch(ctx, m.ID)
+		if err != nil {
+			t.Fatalf("getting match: %v", err)
+		}
+		if m.Winner != "carol" {
+			t.Errorf("want carol to win, got %q", m.Winner)
+		}
+		if progress[2].State != sweeper.GameOngoing || progress[2].CellsRevealed == 0 {
+			t.Errorf("want carol's opened game to be ongoing, got %+v", progress[2])
+		}
+	})
+
+	t.Run("first player to win wins", func(t *testing.T) {
+		t.Parallel()
+
+		svc, m := newMatch(t, "alice", "bob")
+		g, _ := svc.GetGame(ctx, m.Players[1].GameID)
+		g.Cells.Each(func(ref sweeper.CellRef, c sweeper.Cell) {
+			if c.ContainsMine() {
+				if _, err := svc.MakeMove(ctx, g.ID, "bob", ref, sweeper.CellFlagged); err != nil {
+					t.Fatalf("flagging %v: %v", ref, err)
+				}
+			}
+		})
+
+		m, _, err := svc.GetMatch(ctx, m.ID)
+		if err != nil {
+			t.Fatalf("getting match: %v", err)
+		}
+		if m.Winner != "bob" || !m.Finished() {
+			t.Errorf("want bob to win, got %q", m.Winner)
+		}
+	})
+}
+
+func TestService_MakeMoves(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// mines in every even column, with the first safe cell already revealed.
+	newGame := func(t *testing.T) (sweeper.Service, *sweeper.Game) {
+		svc := sweeper.NewService(
+			memory.NewStore(),
+			uuid.New,
+			sequence(1, 0, 2, 4, 6, 2, 1),
+			newFakeClock(),
+		)
+		g, err := svc.StartGame(ctx, "", sweeper.Board{Width: 11, Height: 1, Mines: 6})
+		if err != nil {
+			t.Fatalf("starting game: %v", err)
+		}
+		return svc, g
+	}
+
+	t.Run("failing move applies none", func(t *testing.T) {
+		t.Parallel()
+
+		svc, g := newGame(t)
+		_, _, err := svc.MakeMoves(ctx, g.ID, "", []sweeper.CellMove{
+			{Ref: sweeper.CellRef{Column: 0}, State: sweeper.CellFlagged},
+			{Ref: sweeper.CellRef{Column: 11}, State: sweeper.CellFlagged},
+		})
+
+		var mErr *sweeper.MoveError
+		if !errors.As(err, &mErr) || mErr.Index != 1 || !errors.Is(err, sweeper.ErrOutOfBounds) {
+			t.Fatalf("want move 1 to fail with %v, got %v", sweeper.ErrOutOfBounds, err)
+		}
+
+		g, _ = svc.GetGame(ctx, g.ID)
+		if c := g.Cells.At(sweeper.CellRef{Column: 0}); c.State != sweeper.CellDefault {
+			t.Errorf("want first move not to be applied, got %v", c.State)
+		}
+	})
+
+	t.Run("stops once game ends", func(t *testing.T) {
+		t.Parallel()
+
+		svc, g := newGame(t)
+		var moves []sweeper.CellMove
+		for _, col := range []int{3, 5, 7, 9, 0} {
+			moves = append(moves, sweeper.CellMove{Ref: sweeper.CellRef{Column: col}, State: sweeper.CellRevealed})
+		}
+
+		g, applied, err := svc.MakeMoves(ctx, g.ID, "", moves)
+		if err != nil {
+			t.Fatalf("making moves: %v", err)
+		}
+		if applied != 4 || g.State != sweeper.GameWon {
+			t.Errorf("want 4 moves applied and state %v, got %d and %v", sweeper.GameWon, applied, g.State)
+		}
+	})
+}
+
+func TestService_Spectate(t *testing.T) {
+	t.Parallel()
+
+	const delay = 10 * time.Second
+
+	var (
+		ctx   = context.Background()
+		clock = newFakeClock()
+		svc   = sweeper.NewService(
+			memory.NewStore(),
+			uuid.New,
+			sequence(1, 0, 2, 4, 6, 2, 1),
+			clock,
+		)
+	)
+
+	// mines in every even column, with the first safe cell already revealed.
+	g, err := svc.StartGame(ctx, "", sweeper.Board{Width: 11, Height: 1, Mines: 6})
+	if err != nil {
+		t.Fatalf("starting game: %v", err)
+	}
+
+	var (
+		views = make(chan *sweeper.Game, 10)
+		done  = make(chan error, 1)
+	)
+	go func() {
+		done <- svc.Spectate(ctx, g.ID, delay, func(g *sweeper.Game) error {
+			views <- g
+			return nil
+		})
+	}()
+	// wait for the spectator to be waiting for their first view.
+	for clock.Timers() == 0 {
+		runtime.Gosched()
+	}
+
+	moves := []struct {
+		col   int
+		state sweeper.CellState
+	}{
+		{col: 0, state: sweeper.CellFlagged},
+		{col: 2, state: sweeper.CellQuestioned},
+		{col: 3, state: sweeper.CellRevealed},
+		{col: 0, state: sweeper.CellDefault},
+	}
+	for _, m := range moves {
+		if _, err := svc.MakeMove(ctx, g.ID, "", sweeper.CellRef{Column: m.col}, m.state); err != nil {
+			t.Fatalf("making move: %v", err)
+		}
+	}
+
+	checkFog := func(t *testing.T, g *sweeper.Game) {
+		t.Helper()
+		g.Cells.Each(func(ref sweeper.CellRef, c sweeper.Cell) {
+			if c.State != sweeper.CellRevealed && (c.ContainsMine() || c.NeighbouringMines != 0) {
+				t.Errorf("unrevealed cell %v leaked its contents: %+v", ref, c)
+			}
+		})
+	}
+
+	select {
+	case v := <-views:
+		t.Fatalf("got view before delay: %+v", v)
+	default:
+	}
+
+	clock.Advance(delay)
+	for range len(moves) + 1 {
+		checkFog(t, <-views)
+	}
+
+	// losing the game ends the stream, still without showing the other mines.
+	if _, err := svc.MakeMove(ctx, g.ID, "", sweeper.CellRef{Column: 4}, sweeper.CellRevealed); err != nil {
+		t.Fatalf("making move: %v", err)
+	}
+	clock.Advance(delay)
+
+	last := <-views
+	checkFog(t, last)
+	if last.State != sweeper.GameLost {
+		t.Errorf("want state %v, got %v", sweeper.GameLost, last.State)
+	}
+	if !last.Cells.At(sweeper.CellRef{Column: 4}).ContainsMine() {
+		t.Error("want revealed mine to be shown")
+	}
+	if err := <-done; err != nil {
+		t.Errorf("spectating: %v", err)
+	}
+}
+
+func TestService_ViewGame(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx   = context.Background()
+		svc   = sweeper.NewService(memory.NewStore(), uuid.New, sweeper.SeededNumberGenerator(1), newFakeClock())
+		board = sweeper.Board{Width: 8, Height: 8, Mines: 10}
+	)
+
+	open, err := svc.StartGame(ctx, "", board)
+	if err != nil {
+		t.Fatalf("starting open game: %v", err)
+	}
+	if _, err := svc.ViewGame(ctx, open.ID, "eve"); err != nil {
+		t.Errorf("viewing open game: %v", err)
+	}
+
+	g, err := svc.StartGame(ctx, "alice", board)
+	if err != nil {
+		t.Fatalf("starting game: %v", err)
+	}
+	if _, err := svc.InvitePlayer(ctx, g.ID, "alice", "bob"); err != nil {
+		t.Fatalf("inviting bob: %v", err)
+	}
+	for _, p := range []sweeper.PlayerID{"alice", "bob"} {
+		if _, err := svc.ViewGame(ctx, g.ID, p); err != nil {
+			t.Errorf("viewing as %s: %v", p, err)
+		}
+	}
+	// spectators must wait for the broadcast delay.
+	if _, err := svc.ViewGame(ctx, g.ID, "eve"); !errors.Is(err, sweeper.ErrNotAPlayer) {
+		t.Errorf("viewing ongoing game as spectator: want %v, got %v", sweeper.ErrNotAPlayer, err)
+	}
+	if _, err := svc.EndGame(ctx, g.ID, "alice"); err != nil {
+		t.Fatalf("ending game: %v", err)
+	}
+	if _, err := svc.ViewGame(ctx, g.ID, "eve"); err != nil {
+		t.Errorf("viewing finished game as spectator: %v", err)
+	}
+
+	m, err := svc.StartMatch(ctx, []sweeper.PlayerID{"alice", "bob"}, board)
+	if err != nil {
+		t.Fatalf("starting match: %v", err)
+	}
+	if _, _, err := svc.ViewMatch(ctx, m.ID, "alice"); err != nil {
+		t.Errorf("viewing match as player: %v", err)
+	}
+	if _, _, err := svc.ViewMatch(ctx, m.ID, "eve"); !errors.Is(err, sweeper.ErrNotAPlayer) {
+		t.Errorf("viewing ongoing match as spectator: want %v, got %v", sweeper.ErrNotAPlayer, err)
+	}
+
+	// authenticated callers can only view as themselves.
+	var (
+		bob   = m.Players[1].GameID
+		asBob = sweeper.WithCaller(ctx, "bob")
+	)
+	if _, err := svc.ViewGame(asBob, bob, ""); err != nil {
+		t.Errorf("viewing own game as caller: %v", err)
+	}
+	if _, err := svc.ViewGame(asBob, m.Players[0].GameID, "alice"); !errors.Is(err, sweeper.ErrNotAPlayer) {
+		t.Errorf("viewing opponent's game as them: want %v, got %v", sweeper.ErrNotAPlayer, err)
+	}
+	if _, _, err := svc.ViewMatch(sweeper.WithCaller(ctx, "eve"), m.ID, "alice"); !errors.Is(err, sweeper.ErrNotAPlayer) {
+		t.Errorf("viewing match as a player: want %v, got %v", sweeper.ErrNotAPlayer, err)
+	}
+}
@@ -0,0 +1,208 @@
+package sim
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+
+	"github.com/nightmarlin/sweeper"
+)
+
+// A Report summarises the Boards generated for a Preset.
+type Report struct {
+	Preset Preset `json:"-"`
+	Boards int    `json:"boards"`
+
+	// NoGuess is how many Boards could be won without guessing. It is nil when
+	// not solving.
+	NoGuess *int `json:"no_guess,omitempty"`
+
+	ThreeBV Distribution `json:"3bv"`
+	Opening Distribution `json:"opening"`
+
+	Mines    Heatmap `json:"mines"`    // How many mines each Cell held.
+	Openings Heatmap `json:"openings"` // How often each Cell was the opening.
+}
+
+func newReport(p Preset, solve bool) *Report {
+	r := &Report{
+		Preset:   p,
+		ThreeBV:  Distribution{Counts: map[int]int{}},
+		Opening:  Distribution{Counts: map[int]int{}},
+		Mines:    newHeatmap(p.Board),
+		Openings: newHeatmap(p.Board),
+	}
+	if solve {
+		r.NoGuess = new(int)
+	}
+	return r
+}
+
+func (r *Report) add(m measured) {
+	r.Boards++
+	r.ThreeBV.add(m.stats.ThreeBV)
+	r.Opening.add(m.stats.Opening)
+	if r.NoGuess != nil && m.stats.NoGuess {
+		*r.NoGuess++
+	}
+
+	r.Mines.add(m.mines...)
+	if m.opening != nil {
+		r.Openings.add(*m.opening)
+	} else {
+		r.Openings.add()
+	}
+}
+
+// A Distribution counts how many Boards had each value of a measurement.
+type Distribution struct {
+	Counts map[int]int `json:"counts"`
+	Min    int         `json:"min"`
+	Max    int         `json:"max"`
+	Mean   float64     `json:"mean"`
+
+	total int
+}
+
+func (d *Distribution) add(n int) {
+	if d.total == 0 || n < d.Min {
+		d.Min = n
+	}
+	d.Max = max(d.Max, n)
+	d.Counts[n]++
+	d.total++
+	d.Mean += (float64(n) - d.Mean) / float64(d.total)
+}
+
+// values returns the values seen, in ascending order.
+func (d Distribution) values() []int {
+	res := make([]int, 0, len(d.Counts))
+	for n := range d.Counts {
+		res = append(res, n)
+	}
+	slices.Sort(res)
+	return res
+}
+
+// A Heatmap counts how often something happened in each Cell of a Board. It is
+// written as the mean count per Board, by layer, row, then column.
+type Heatmap struct {
+	board  sweeper.Board
+	counts []int
+	boards int
+}
+
+func newHeatmap(b sweeper.Board) Heatmap {
+	return Heatmap{board: b, counts: make([]int, b.Width*b.Height*max(b.Depth, 1))}
+}
+
+func (h *Heatmap) index(ref sweeper.CellRef) int {
+	return (ref.Layer*h.board.Height+ref.Row)*h.board.Width + ref.Column
+}
+
+// add counts a Board in which something happened in each of the Cells.
+func (h *Heatmap) add(refs ...sweeper.CellRef) {
+	h.boards++
+	for _, ref := range refs {
+		h.counts[h.index(ref)]++
+	}
+}
+
+// At returns the mean count in the Cell per Board.
+func (h Heatmap) At(ref sweeper.CellRef) float64 {
+	if h.boards == 0 {
+		return 0
+	}
+	return float64(h.counts[h.index(ref)]) / float64(h.boards)
+}
+
+// refs returns every Cell on the Board, in the order they are written.
+func (h Heatmap) refs() []sweeper.CellRef {
+	res := make([]sweeper.CellRef, 0, len(h.counts))
+	for layer := range max(h.board.Depth, 1) {
+		for row := range h.board.Height {
+			for col := range h.board.Width {
+				res = append(res, sweeper.CellRef{Layer: layer, Row: row, Column: col})
+			}
+		}
+	}
+	return res
+}
+
+func (h Heatmap) MarshalJSON() ([]byte, error) {
+	layers := make([][][]float64, max(h.board.Depth, 1))
+	for _, ref := range h.refs() {
+		if ref.Row == 0 && ref.Column == 0 {
+			layers[ref.Layer] = make([][]float64, h.board.Height)
+		}
+		layers[ref.Layer][ref.Row] = append(layers[ref.Layer][ref.Row], h.At(ref))
+	}
+	return json.Marshal(layers)
+}
+
+// WriteJSON writes the Reports as a JSON object keyed by Preset name.
+func WriteJSON(w io.Writer, reports []*Report) error {
+	byName := make(map[string]*Report, len(reports))
+	for _, r := range reports {
+		byName[r.Preset.Name] = r
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(byName)
+}
+
+// WriteCSV writes the Reports as CSV, with one measurement per row:
+//
+//	preset,metric,key,value
+//	beginner,boards,,1000
+//	beginner,no_guess,,412
+//	beginner,3bv,31,27
+//	beginner,mines,0:0:0,0.1234
+//
+// Distributions are written one value per row, keyed by the value and giving
+// the number of Boards, and Heatmaps one Cell per row, keyed by
+// layer:row:column and giving the mean count per Board.
+func WriteCSV(w io.Writer, reports []*Report) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"preset", "metric", "key", "value"}); err != nil {
+		return err
+	}
+
+	for _, r := range reports {
+		name := r.Preset.Name
+		rows := [][]string{{name, "boards", "", strconv.Itoa(r.Boards)}}
+		if r.NoGuess != nil {
+			rows = append(rows, []string{name, "no_guess", "", strconv.Itoa(*r.NoGuess)})
+		}
+		for _, d := range []struct {
+			metric string
+			dist   Distribution
+		}{{"3bv", r.ThreeBV}, {"opening", r.Opening}} {
+			for _, n := range d.dist.values() {
+				rows = append(rows, []string{name, d.metric, strconv.Itoa(n), strconv.Itoa(d.dist.Counts[n])})
+			}
+		}
+		for _, h := range []struct {
+			metric string
+			heat   Heatmap
+		}{{"mines", r.Mines}, {"openings", r.Openings}} {
+			for _, ref := range h.heat.refs() {
+				rows = append(rows, []string{
+					name,
+					h.metric,
+					fmt.Sprintf("%d:%d:%d", ref.Layer, ref.Row, ref.Column),
+					strconv.FormatFloat(h.heat.At(ref), 'f', -1, 64),
+				})
+			}
+		}
+
+		if err := cw.WriteAll(rows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+// Package sim generates Boards in bulk and measures them, to check whether the
+// way mines are placed and Games are opened makes some Games harder than
+// others.
+package sim
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+	"github.com/nightmarlin/sweeper/bot"
+)
+
+// A Preset is a kind of Board to generate.
+type Preset struct {
+	Name  string
+	Board sweeper.Board
+}
+
+// Config describes a simulation.
+type Config struct {
+	Boards int // How many Boards to generate for each Preset.
+
+	// NumberGen places the mines and picks the opening of every Board, as it
+	// would for the Service.
+	NumberGen sweeper.NumberGenerator
+
+	// Solve checks whether each Board can be won without guessing. It is by far
+	// the slowest measurement.
+	Solve bool
+
+	// Workers is how many Boards are measured at once. If 0, one per CPU is used.
+	// Boards are always generated one at a time, in order, so that the same
+	// NumberGen always gives the same results.
+	Workers int
+}
+
+// Stats are the measurements of a single Board.
+type Stats struct {
+	// ThreeBV is the Bechtel's Board Benchmark Value: the fewest clicks that
+	// reveal every safe Cell without flagging. Each empty region counts as one,
+	// as does each numbered Cell not on the edge of one.
+	ThreeBV int
+	// Opening is the number of Cells revealed when the Game was created.
+	Opening int
+	// NoGuess reports whether the Game can be won by deduction alone. It is only
+	// set when solving.
+	NoGuess bool
+}
+
+// Run generates Boards for each Preset in turn, returning a Report for each.
+func Run(ctx context.Context, cfg Config, presets ...Preset) ([]*Report, error) {
+	res := make([]*Report, 0, len(presets))
+	for _, p := range presets {
+		r, err := run(ctx, cfg, p)
+		if err != nil {
+			return res, fmt.Errorf("simulating %s: %w", p.Name, err)
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+// measured is a Board along with its Stats.
+type measured struct {
+	stats   Stats
+	mines   []sweeper.CellRef
+	opening *sweeper.CellRef
+}
+
+func run(ctx context.Context, cfg Config, p Preset) (*Report, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		workers = cfg.Workers
+		games   = make(chan *sweeper.Game, 64)
+		results = make(chan measured, 64)
+		errs    = make(chan error, 1)
+		wg      sync.WaitGroup
+	)
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	fail := func(err error) {
+		select {
+		case errs <- err:
+		default:
+		}
+		cancel()
+	}
+
+	go func() {
+		defer close(games)
+		for range cfg.Boards {
+			g, err := sweeper.NewGame(ctx, uuid.New, cfg.NumberGen, p.Board)
+			if err != nil {
+				fail(err)
+				return
+			}
+			select {
+			case games <- g:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for g := range games {
+				m := measured{mines: mines(g), opening: g.Opening}
+				stats, err := Measure(ctx, g, cfg.Solve)
+				if err != nil {
+					fail(err)
+					return
+				}
+				m.stats = stats
+				select {
+				case results <- m:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	r := newReport(p, cfg.Solve)
+	for m := range results {
+		r.add(m)
+	}
+
+	select {
+	case err := <-errs:
+		return nil, err
+	default:
+	}
+	// the caller's context may have ended the simulation early too.
+	if err := ctx.Err(); err != nil && r.Boards < cfg.Boards {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Measure measures the newly created Game. When solving, moves are made on it,
+// so the Game should not be used afterwards.
+func Measure(ctx context.Context, g *sweeper.Game, solve bool) (Stats, error) {
+	s := Stats{ThreeBV: threeBV(g)}
+	for i := range g.Cells.Len() {
+		if g.Cells.Nth(i).State == sweeper.CellRevealed {
+			s.Opening++
+		}
+	}
+
+	if solve {
+		var err error
+		if s.NoGuess, err = solvable(ctx, g); err != nil {
+			return Stats{}, err
+		}
+	}
+	return s, nil
+}
+
+// threeBV counts the regions of empty Cells, and the numbered Cells outside
+// them.
+func threeBV(g *sweeper.Game) int {
+	var (
+		n       int
+		cleared = make([]bool, g.Cells.Len())
+		stack   []sweeper.CellRef
+	)
+
+	for i := range g.Cells.Len() {
+		if c := g.Cells.Nth(i); c.ContainsMine() || c.NeighbouringMines != 0 || cleared[i] {
+			continue
+		}
+
+		// clicking the empty region clears it, along with the numbers around it.
+		n++
+		cleared[i] = true
+		stack = append(stack[:0], g.Cells.Ref(i))
+		for len(stack) > 0 {
+			cur := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, nb := range g.Board.Neighbours(cur) {
+				j, nc := g.Cells.IndexOf(nb), g.Cells.At(nb)
+				if cleared[j] || nc.ContainsMine() {
+					continue
+				}
+				cleared[j] = true
+				if nc.NeighbouringMines == 0 {
+					stack = append(stack, nb)
+				}
+			}
+		}
+	}
+
+	for i := range g.Cells.Len() {
+		if !g.Cells.Nth(i).ContainsMine() && !cleared[i] {
+			n++
+		}
+	}
+	return n
+}
+
+// solvable plays the Game using only moves that bot.Deduce can prove, reporting
+// whether that is enough to win it. Deduce only looks at what players can see,
+// so it can be given the whole Game.
+func solvable(ctx context.Context, g *sweeper.Game) (bool, error) {
+	for g.State == sweeper.GameOngoing {
+		m, ok, err := bot.Deduce(ctx, g)
+		if err != nil || !ok {
+			return false, err
+		}
+		if err := g.Apply(sweeper.Move{Ref: m.Ref, State: m.State}); err != nil {
+			return false, fmt.Errorf("applying deduced move: %w", err)
+		}
+	}
+	return g.State == sweeper.GameWon, nil
+}
+
+// mines returns the Cells containing mines, once for each mine.
+func mines(g *sweeper.Game) []sweeper.CellRef {
+	res := make([]sweeper.CellRef, 0, g.Board.Mines)
+	g.Cells.Each(func(ref sweeper.CellRef, c sweeper.Cell) {
+		for range c.Mines {
+			res = append(res, ref)
+		}
+	})
+	return slices.Clip(res)
+}
@@ -0,0 +1,116 @@
+package sim_test
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+	"github.com/nightmarlin/sweeper/sim"
+)
+
+func TestMeasure(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		layout string
+		want   sim.Stats
+	}{
+		"one region": {
+			layout: "*..\n...\n..o\n",
+			want:   sim.Stats{ThreeBV: 1, Opening: 8, NoGuess: true},
+		},
+		"number outside region": {
+			// the 2 between the mines isn't next to any empty cell.
+			layout: "*.*\n...\n...\n",
+			want:   sim.Stats{ThreeBV: 2},
+		},
+		"solvable from opening": {
+			layout: "..*.o\n",
+			want:   sim.Stats{ThreeBV: 2, Opening: 2, NoGuess: true},
+		},
+		"needs a guess": {
+			// the mine could be in either cell of the bottom row.
+			layout: "o.\n..\n*.\n",
+			want:   sim.Stats{ThreeBV: 2, Opening: 4},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			board, layout, err := sweeper.ParseLayout(tc.layout)
+			if err != nil {
+				t.Fatalf("parsing layout: %v", err)
+			}
+			g, err := sweeper.NewGameFromLayout(uuid.New, board, layout)
+			if err != nil {
+				t.Fatalf("creating game: %v", err)
+			}
+
+			got, err := sim.Measure(context.Background(), g, true)
+			if err != nil {
+				t.Fatalf("measuring: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("want %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	preset := sim.Preset{Name: "small", Board: sweeper.Board{Width: 5, Height: 4, Mines: 5}}
+	run := func(workers int) []*sim.Report {
+		t.Helper()
+
+		cfg := sim.Config{
+			Boards:    500,
+			NumberGen: sweeper.SeededNumberGenerator(1),
+			Solve:     true,
+			Workers:   workers,
+		}
+		res, err := sim.Run(context.Background(), cfg, preset)
+		if err != nil {
+			t.Fatalf("running: %v", err)
+		}
+		return res
+	}
+
+	res := run(4)
+	if len(res) != 1 {
+		t.Fatalf("want 1 report, got %d", len(res))
+	}
+	r := res[0]
+	if r.Boards != 500 || r.ThreeBV.Min < 1 || r.Opening.Min < 1 {
+		t.Errorf("want 500 boards with openings, got %d boards, 3bv %+v, openings %+v", r.Boards, r.ThreeBV, r.Opening)
+	}
+
+	var mines, openings float64
+	for row := range preset.Board.Height {
+		for col := range preset.Board.Width {
+			ref := sweeper.CellRef{Row: row, Column: col}
+			mines += r.Mines.At(ref)
+			openings += r.Openings.At(ref)
+		}
+	}
+	if math.Abs(mines-5) > 1e-9 || math.Abs(openings-1) > 1e-9 {
+		t.Errorf("want 5 mines and 1 opening per board, got %f and %f", mines, openings)
+	}
+
+	// boards are generated in order, so the results don't depend on the workers.
+	var want, got bytes.Buffer
+	if err := sim.WriteCSV(&want, res); err != nil {
+		t.Fatalf("writing csv: %v", err)
+	}
+	if err := sim.WriteCSV(&got, run(1)); err != nil {
+		t.Fatalf("writing csv: %v", err)
+	}
+	if want.String() != got.String() {
+		t.Error("want the same results with any number of workers")
+	}
+}
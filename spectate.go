@@ -0,0 +1,168 @@
+package sweeper
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SpectatorView returns a copy of the Game holding only what its Players can
+// see: Cells that have not been revealed never report their mines, whatever
+// state the Game is in.
+func (g *Game) SpectatorView() *Game {
+	res := g.Clone()
+	for i, c := range res.Cells.cells {
+		if c.State != CellRevealed {
+			res.Cells.cells[i].Mines, res.Cells.cells[i].NeighbouringMines = 0, 0
+		}
+	}
+	return res
+}
+
+// watchers tracks the spectators of each Game, so they can be told whenever it
+// changes.
+type watchers struct {
+	mux  sync.Mutex
+	subs map[uuid.UUID]map[*watcher]struct{}
+}
+
+type watcher struct {
+	mux     sync.Mutex
+	pending []snapshot
+	notify  chan struct{} // signalled when pending grows
+}
+
+// A snapshot is a spectator's view of a Game, and when the Game reached it.
+type snapshot struct {
+	g  *Game
+	at time.Time
+}
+
+func newWatchers() *watchers {
+	return &watchers{subs: make(map[uuid.UUID]map[*watcher]struct{})}
+}
+
+func (ws *watchers) subscribe(gameID uuid.UUID) (w *watcher, unsubscribe func()) {
+	w = &watcher{notify: make(chan struct{}, 1)}
+
+	defer ws.mux.Unlock()
+	ws.mux.Lock()
+
+	if ws.subs[gameID] == nil {
+		ws.subs[gameID] = make(map[*watcher]struct{})
+	}
+	ws.subs[gameID][w] = struct{}{}
+
+	return w, func() {
+		defer ws.mux.Unlock()
+		ws.mux.Lock()
+
+		delete(ws.subs[gameID], w)
+		if len(ws.subs[gameID]) == 0 {
+			delete(ws.subs, gameID)
+		}
+	}
+}
+
+// publish tells the Game's spectators that it changed at the given time.
+func (ws *watchers) publish(g *Game, at time.Time) {
+	ws.mux.Lock()
+	subs := make([]*watcher, 0, len(ws.subs[g.ID]))
+	for w := range ws.subs[g.ID] {
+		subs = append(subs, w)
+	}
+	ws.mux.Unlock()
+
+	if len(subs) == 0 {
+		return
+	}
+
+	view := g.SpectatorView()
+	for _, w := range subs {
+		w.push(snapshot{g: view, at: at})
+	}
+}
+
+func (w *watcher) push(s snapshot) {
+	w.mux.Lock()
+	w.pending = append(w.pending, s)
+	w.mux.Unlock()
+
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+// due removes and returns the snapshots that may be shown at now.
+func (w *watcher) due(now time.Time, delay time.Duration) (res []snapshot, next time.Time) {
+	defer w.mux.Unlock()
+	w.mux.Lock()
+
+	i := 0
+	for ; i < len(w.pending) && !w.pending[i].at.Add(delay).After(now); i++ {
+	}
+	res, w.pending = w.pending[:i:i], w.pending[i:]
+
+	if len(w.pending) > 0 {
+		next = w.pending[0].at.Add(delay)
+	}
+	return res, next
+}
+
+// Spectate calls send with a SpectatorView of the Game each time it changes,
+// until it finishes or ctx is cancelled. Each view is held back until delay
+// has passed since the change, so that spectating a Match can't be used to
+// help one of its players. That only holds while spectators can't ViewGame as
+// one of its players instead, which takes authenticated callers (see
+// WithCaller).
+func (s Service) Spectate(
+	ctx context.Context,
+	gameID uuid.UUID,
+	delay time.Duration,
+	send func(g *Game) error,
+) error {
+	// subscribe before fetching the game so no changes are missed.
+	w, unsubscribe := s.watchers.subscribe(gameID)
+	defer unsubscribe()
+
+	g, err := s.GetGame(ctx, gameID)
+	if err != nil {
+		return err
+	}
+	w.push(snapshot{g: g.SpectatorView(), at: s.clock.Now()})
+
+	wake := make(chan struct{}, 1)
+	for {
+		due, next := w.due(s.clock.Now(), delay)
+		for _, snap := range due {
+			if err := send(snap.g); err != nil {
+				return err
+			}
+			if snap.g.finished() {
+				return nil
+			}
+		}
+
+		stop := func() bool { return false }
+		if !next.IsZero() {
+			stop = s.clock.AfterFunc(next.Sub(s.clock.Now()), func() {
+				select {
+				case wake <- struct{}{}:
+				default:
+				}
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			stop()
+			return ctx.Err()
+		case <-w.notify:
+		case <-wake:
+		}
+		stop()
+	}
+}
@@ -0,0 +1,103 @@
+package sweeper
+
+// A Topology describes the shape of the Cells on a Board, and so which Cells
+// neighbour each other.
+type Topology int
+
+const (
+	// TopologySquare lays Cells out on a square grid. Each Cell neighbours the
+	// 8 Cells that share an edge or corner with it.
+	TopologySquare = Topology(iota)
+
+	// TopologyHex lays Cells out on a hexagonal grid, with odd rows offset half
+	// a Cell to the right. Each Cell neighbours the 6 Cells that share an edge
+	// with it.
+	TopologyHex
+
+	// TopologyTriangle lays Cells out on a triangular grid, where the Cell at
+	// (0, 0) points up and each Cell points the opposite way to the Cells to
+	// its left and right. Each Cell neighbours the 12 Cells that share an edge
+	// or corner with it.
+	TopologyTriangle
+)
+
+// An offset is the position of a neighbouring Cell, relative to a Cell.
+type offset struct{ row, column int }
+
+var (
+	squareOffsets = []offset{
+		{-1, -1}, {-1, 0}, {-1, 1},
+		{0, -1}, {0, 1},
+		{1, -1}, {1, 0}, {1, 1},
+	}
+
+	hexEvenRowOffsets = []offset{
+		{-1, -1}, {-1, 0},
+		{0, -1}, {0, 1},
+		{1, -1}, {1, 0},
+	}
+	hexOddRowOffsets = []offset{
+		{-1, 0}, {-1, 1},
+		{0, -1}, {0, 1},
+		{1, 0}, {1, 1},
+	}
+
+	triangleUpOffsets = []offset{
+		{-1, -1}, {-1, 0}, {-1, 1},
+		{0, -2}, {0, -1}, {0, 1}, {0, 2},
+		{1, -2}, {1, -1}, {1, 0}, {1, 1}, {1, 2},
+	}
+	triangleDownOffsets = []offset{
+		{-1, -2}, {-1, -1}, {-1, 0}, {-1, 1}, {-1, 2},
+		{0, -2}, {0, -1}, {0, 1}, {0, 2},
+		{1, -1}, {1, 0}, {1, 1},
+	}
+)
+
+// Neighbours returns the CellRefs of every Cell in the same layer neighbouring
+// the Cell at ref. It does not take the bounds of any Board into account.
+func (t Topology) Neighbours(ref CellRef) []CellRef {
+	return t.appendNeighbours(nil, ref)
+}
+
+// appendNeighbours appends the neighbours of the Cell at ref to dst, so that
+// callers walking a whole Board can reuse one slice.
+func (t Topology) appendNeighbours(dst []CellRef, ref CellRef) []CellRef {
+	var offsets []offset
+	switch t {
+	case TopologyHex:
+		offsets = hexEvenRowOffsets
+		if ref.Row%2 != 0 {
+			offsets = hexOddRowOffsets
+		}
+	case TopologyTriangle:
+		offsets = triangleUpOffsets
+		if !PointsUp(ref) {
+			offsets = triangleDownOffsets
+		}
+	default:
+		offsets = squareOffsets
+	}
+
+	for _, o := range offsets {
+		dst = append(dst, CellRef{Layer: ref.Layer, Row: ref.Row + o.row, Column: ref.Column + o.column})
+	}
+	return dst
+}
+
+// PointsUp reports whether the Cell at ref points up on a TopologyTriangle
+// Board.
+func PointsUp(ref CellRef) bool { return (ref.Row+ref.Column)%2 == 0 }
+
+func (t Topology) String() string {
+	switch t {
+	case TopologySquare:
+		return "square"
+	case TopologyHex:
+		return "hex"
+	case TopologyTriangle:
+		return "triangle"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,427 @@
+// Package tournament runs single-elimination tournaments, where every heat is a
+// sweeper.Match played on identical seeded boards.
+package tournament
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+)
+
+type Format int
+
+const (
+	// FormatBestOf pairs play heats until one of them has won a majority of
+	// BestOf heats. Heats are won like a sweeper.Match: by the first player to
+	// win, or the last player not to lose.
+	FormatBestOf = Format(iota)
+	// FormatFastest pairs play a single heat, won by whoever cleared their board
+	// in the least time since their Game started. The opening is revealed when
+	// the Game starts, so studying it counts too.
+	FormatFastest
+)
+
+// A Tournament is a single-elimination bracket. Players who aren't paired in
+// the first round are given a bye, with the highest seeds given byes first.
+type Tournament struct {
+	ID        uuid.UUID
+	Organiser sweeper.PlayerID
+	Board     sweeper.Board
+	Format    Format
+	BestOf    int // Only used by FormatBestOf.
+
+	// Players are the players taking part, in seed order.
+	Players []sweeper.PlayerID
+	// Rounds are the rounds played so far. Only the last may be unfinished.
+	Rounds []Round
+	// Winner is the player that won the final round, once it has been played.
+	Winner sweeper.PlayerID
+}
+
+type Round struct {
+	Pairings []Pairing
+}
+
+// A Pairing is a contest between players in a Round. Pairings with a single
+// player are byes, which that player wins without playing.
+type Pairing struct {
+	Players []sweeper.PlayerID
+	Heats   []Heat
+	Wins    []int // The number of heats won by each of the Players.
+	Winner  sweeper.PlayerID
+}
+
+// A Heat is a sweeper.Match played as part of a Pairing.
+type Heat struct {
+	MatchID uuid.UUID
+	GameIDs []uuid.UUID // The Game played by each of the Pairing's Players.
+	// Times are how long each of the Pairing's Players took to win their Game,
+	// or negative if they didn't. Winning in no time at all is zero.
+	Times    []time.Duration
+	Winner   sweeper.PlayerID // Empty if nobody won the Heat, so it must be replayed.
+	Finished bool
+}
+
+// Clone returns a deep copy of the Tournament.
+func (t *Tournament) Clone() *Tournament {
+	res := *t
+	res.Players = slices.Clone(t.Players)
+	res.Rounds = slices.Clone(t.Rounds)
+	for i, r := range res.Rounds {
+		r.Pairings = slices.Clone(r.Pairings)
+		for j, p := range r.Pairings {
+			p.Players = slices.Clone(p.Players)
+			p.Wins = slices.Clone(p.Wins)
+			p.Heats = slices.Clone(p.Heats)
+			for k, h := range p.Heats {
+				h.GameIDs = slices.Clone(h.GameIDs)
+				h.Times = slices.Clone(h.Times)
+				p.Heats[k] = h
+			}
+			r.Pairings[j] = p
+		}
+		res.Rounds[i] = r
+	}
+	return &res
+}
+
+// Finished reports whether the Tournament has been won.
+func (t *Tournament) Finished() bool { return t.Winner != "" }
+
+// Service is the subset of sweeper.Service the Manager uses to play heats.
+type Service interface {
+	StartMatch(ctx context.Context, players []sweeper.PlayerID, board sweeper.Board) (*sweeper.Match, error)
+	GetGame(ctx context.Context, gameID uuid.UUID) (*sweeper.Game, error)
+	Spectate(ctx context.Context, gameID uuid.UUID, delay time.Duration, send func(g *sweeper.Game) error) error
+}
+
+// Store persists Tournaments. GetTournament must return ErrTournamentNotFound
+// if there is no Tournament with the ID.
+type Store interface {
+	SaveTournament(ctx context.Context, t *Tournament) error
+	GetTournament(ctx context.Context, id uuid.UUID) (*Tournament, error)
+}
+
+// Manager runs Tournaments, starting each heat and collecting its result as
+// soon as its Games finish. Heats that can't be settled are logged, as there's
+// nobody waiting on them to report to.
+type Manager struct {
+	svc   Service
+	store Store
+	idGen sweeper.IDGenerator
+	log   *slog.Logger
+
+	// mux is held while updating a Tournament, so heats finishing together
+	// settle one at a time.
+	mux sync.Mutex
+	// stopWatching stops watching the Games of each unfinished Heat, by MatchID.
+	stopWatching map[uuid.UUID]context.CancelFunc
+}
+
+func New(svc Service, store Store, idGen sweeper.IDGenerator, log *slog.Logger) *Manager {
+	return &Manager{
+		svc:          svc,
+		store:        store,
+		idGen:        idGen,
+		log:          log,
+		stopWatching: make(map[uuid.UUID]context.CancelFunc),
+	}
+}
+
+// Create starts a Tournament between the players, in seed order, and starts
+// the heats of its first round.
+func (m *Manager) Create(
+	ctx context.Context,
+	organiser sweeper.PlayerID,
+	players []sweeper.PlayerID,
+	board sweeper.Board,
+	format Format,
+	bestOf int,
+) (*Tournament, error) {
+	if len(players) < 2 {
+		return nil, sweeper.ErrTooFewPlayers
+	}
+	for i, p := range players {
+		if p == "" || slices.Contains(players[:i], p) {
+			return nil, fmt.Errorf("%w: %q", sweeper.ErrDuplicatePlayer, p)
+		}
+	}
+	switch {
+	case format != FormatBestOf && format != FormatFastest:
+		return nil, fmt.Errorf("%w: unknown format (%d)", ErrInvalidTournament, format)
+	case format == FormatBestOf && bestOf < 1:
+		return nil, fmt.Errorf("%w: must play at least 1 heat", ErrInvalidTournament)
+	}
+
+	t := &Tournament{
+		ID:        m.idGen(),
+		Organiser: organiser,
+		Board:     board,
+		Format:    format,
+		BestOf:    bestOf,
+		Players:   slices.Clone(players),
+	}
+
+	defer m.mux.Unlock()
+	m.mux.Lock()
+
+	err := m.startRound(ctx, t, t.Players)
+	if err == nil {
+		err = m.store.SaveTournament(ctx, t)
+	}
+	if err != nil {
+		// nothing will settle the heats that did start.
+		for _, p := range t.Rounds[0].Pairings {
+			for _, h := range p.Heats {
+				m.stopWatchingHeat(h)
+			}
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+func (m *Manager) Get(ctx context.Context, id uuid.UUID) (*Tournament, error) {
+	return m.store.GetTournament(ctx, id)
+}
+
+// startRound pairs the players, who must be in seed order, and starts the
+// first heat of each Pairing.
+func (m *Manager) startRound(ctx context.Context, t *Tournament, players []sweeper.PlayerID) error {
+	size := 1
+	for size < len(players) {
+		size *= 2
+	}
+	byes := size - len(players)
+
+	var r Round
+	for _, p := range players[:byes] {
+		r.Pairings = append(r.Pairings, Pairing{Players: []sweeper.PlayerID{p}, Wins: []int{0}, Winner: p})
+	}
+	// the highest seeds left play the lowest.
+	rest := players[byes:]
+	for i := range len(rest) / 2 {
+		r.Pairings = append(r.Pairings, Pairing{
+			Players: []sweeper.PlayerID{rest[i], rest[len(rest)-1-i]},
+			Wins:    []int{0, 0},
+		})
+	}
+
+	t.Rounds = append(t.Rounds, r)
+	round := len(t.Rounds) - 1
+	for i, p := range r.Pairings {
+		if p.Winner != "" {
+			continue
+		}
+		if err := m.startHeat(ctx, t, round, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Manager) startHeat(ctx context.Context, t *Tournament, round, pairing int) error {
+	p := &t.Rounds[round].Pairings[pairing]
+
+	match, err := m.svc.StartMatch(ctx, p.Players, t.Board)
+	if err != nil {
+		return fmt.Errorf("starting heat: %w", err)
+	}
+
+	h := Heat{MatchID: match.ID, Times: make([]time.Duration, len(p.Players))}
+	for i := range h.Times {
+		h.Times[i] = -1
+	}
+	for _, mp := range match.Players {
+		h.GameIDs = append(h.GameIDs, mp.GameID)
+	}
+	p.Heats = append(p.Heats, h)
+
+	// the games are watched until the heat is decided, which may be before they
+	// all finish, or never if they are abandoned.
+	watchCtx, stop := context.WithCancel(context.Background())
+	m.stopWatching[match.ID] = stop
+
+	heat := len(p.Heats) - 1
+	for _, id := range h.GameIDs {
+		go m.watch(watchCtx, t.ID, round, pairing, heat, id)
+	}
+	return nil
+}
+
+// stopWatchingHeat stops watching the Heat's Games.
+func (m *Manager) stopWatchingHeat(h Heat) {
+	if stop, ok := m.stopWatching[h.MatchID]; ok {
+		stop()
+		delete(m.stopWatching, h.MatchID)
+	}
+}
+
+// watch waits for the Game to finish, then settles the Heat it is part of. It
+// gives up once ctx is cancelled.
+func (m *Manager) watch(ctx context.Context, tournamentID uuid.UUID, round, pairing, heat int, gameID uuid.UUID) {
+	if err := m.svc.Spectate(ctx, gameID, 0, func(*sweeper.Game) error { return nil }); err != nil {
+		return
+	}
+	// settling may start more heats, which must outlive this one.
+	ctx = context.Background()
+
+	defer m.mux.Unlock()
+	m.mux.Lock()
+
+	log := m.log.With(
+		slog.String("tournament", tournamentID.String()),
+		slog.Int("round", round),
+		slog.Int("pairing", pairing),
+		slog.Int("heat", heat),
+	)
+
+	t, err := m.store.GetTournament(ctx, tournamentID)
+	if errors.Is(err, ErrTournamentNotFound) {
+		return // the tournament failed to start
+	}
+	if err != nil {
+		log.Error("failed to get tournament", slog.String("error", err.Error()))
+		return
+	}
+
+	// the heat's board was valid when it started, so settling it can only fail
+	// if the Service does. The Tournament is saved anyway, as the heat may have
+	// been decided and others started before it did.
+	if err := m.settle(ctx, t, round, pairing, heat); err != nil {
+		log.Error("failed to settle heat", slog.String("error", err.Error()))
+	}
+	if err := m.store.SaveTournament(ctx, t); err != nil {
+		log.Error("failed to save tournament", slog.String("error", err.Error()))
+	}
+}
+
+// settle decides the Heat if it can, advancing the Tournament if that decides
+// its Pairing.
+func (m *Manager) settle(ctx context.Context, t *Tournament, round, pairing, heat int) error {
+	p := &t.Rounds[round].Pairings[pairing]
+	h := &p.Heats[heat]
+	if h.Finished {
+		return nil
+	}
+
+	games := make([]*sweeper.Game, 0, len(h.GameIDs))
+	for _, id := range h.GameIDs {
+		g, err := m.svc.GetGame(ctx, id)
+		if err != nil {
+			return fmt.Errorf("getting game: %w", err)
+		}
+		games = append(games, g)
+	}
+
+	winner, ok := decide(t.Format, games, h.Times)
+	if !ok {
+		return nil
+	}
+	h.Finished = true
+	m.stopWatchingHeat(*h)
+
+	if winner >= 0 {
+		h.Winner = p.Players[winner]
+		p.Wins[winner]++
+	}
+
+	need := 1
+	if t.Format == FormatBestOf {
+		need = t.BestOf/2 + 1
+	}
+	if winner < 0 || p.Wins[winner] < need {
+		return m.startHeat(ctx, t, round, pairing)
+	}
+	p.Winner = h.Winner
+
+	return m.advance(ctx, t)
+}
+
+// advance starts the next round once every Pairing in the current one has a
+// winner.
+func (m *Manager) advance(ctx context.Context, t *Tournament) error {
+	r := t.Rounds[len(t.Rounds)-1]
+
+	var winners []sweeper.PlayerID
+	for _, p := range r.Pairings {
+		if p.Winner == "" {
+			return nil
+		}
+		winners = append(winners, p.Winner)
+	}
+
+	if len(winners) == 1 {
+		t.Winner = winners[0]
+		return nil
+	}
+
+	slices.SortFunc(winners, func(a, b sweeper.PlayerID) int {
+		return slices.Index(t.Players, a) - slices.Index(t.Players, b)
+	})
+	return m.startRound(ctx, t, winners)
+}
+
+// decide returns the index of the Game that won the heat, or -1 if nobody did.
+// It reports false if the heat can't be decided yet. The time taken to win each
+// Game is recorded in times.
+func decide(f Format, games []*sweeper.Game, times []time.Duration) (winner int, ok bool) {
+	var (
+		ongoing   []int
+		fastest   = -1
+		firstDone time.Time
+	)
+	for i, g := range games {
+		switch g.State {
+		case sweeper.GameOngoing:
+			ongoing = append(ongoing, i)
+
+		case sweeper.GameWon:
+			end := g.StartedAt
+			if len(g.Moves) > 0 {
+				end = g.Moves[len(g.Moves)-1].At
+			}
+			times[i] = end.Sub(g.StartedAt)
+
+			switch f {
+			case FormatBestOf:
+				if fastest < 0 || end.Before(firstDone) {
+					fastest, firstDone = i, end
+				}
+			case FormatFastest:
+				if fastest < 0 || times[i] < times[fastest] {
+					fastest = i
+				}
+			}
+		}
+	}
+
+	switch f {
+	case FormatBestOf:
+		switch {
+		case fastest >= 0:
+			return fastest, true
+		case len(ongoing) == 1:
+			return ongoing[0], true
+		}
+	case FormatFastest:
+		if len(ongoing) > 0 {
+			return 0, false
+		}
+		return fastest, true
+	}
+	return -1, len(ongoing) == 0
+}
+
+var (
+	ErrTournamentNotFound = fmt.Errorf("tournament not found")
+	ErrInvalidTournament  = fmt.Errorf("invalid tournament")
+)
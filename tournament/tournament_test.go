@@ -0,0 +1,349 @@
+package tournament_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nightmarlin/sweeper"
+	"github.com/nightmarlin/sweeper/infra/memory"
+	"github.com/nightmarlin/sweeper/internal/fakeclock"
+	"github.com/nightmarlin/sweeper/tournament"
+)
+
+var board = sweeper.Board{Width: 6, Height: 6, Mines: 8}
+
+type harness struct {
+	t     *testing.T
+	ctx   context.Context
+	clock *fakeclock.Clock
+	svc   sweeper.Service
+	store *memory.Store
+	logs  *logBuffer
+	m     *tournament.Manager
+}
+
+func newHarness(t *testing.T) *harness {
+	var (
+		clock = fakeclock.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		store = memory.NewStore()
+		svc   = sweeper.NewService(store, uuid.New, sweeper.SeededNumberGenerator(1), clock)
+		logs  = &logBuffer{}
+	)
+	return &harness{
+		t:     t,
+		ctx:   context.Background(),
+		clock: clock,
+		svc:   svc,
+		store: store,
+		logs:  logs,
+		m:     tournament.New(svc, store, uuid.New, slog.New(slog.NewTextHandler(logs, nil))),
+	}
+}
+
+// logBuffer is a bytes.Buffer the Manager's goroutines can log to.
+type logBuffer struct {
+	mux sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	defer b.mux.Unlock()
+	b.mux.Lock()
+	return b.buf.Write(p)
+}
+
+func (b *logBuffer) String() string {
+	defer b.mux.Unlock()
+	b.mux.Lock()
+	return b.buf.String()
+}
+
+// mine returns a Cell in the Game containing a mine.
+func (h *harness) mine(g *sweeper.Game) sweeper.CellRef {
+	for i := range g.Cells.Len() {
+		if g.Cells.Nth(i).ContainsMine() {
+			return g.Cells.Ref(i)
+		}
+	}
+	h.t.Fatal("game has no mines")
+	return sweeper.CellRef{}
+}
+
+func (h *harness) game(id uuid.UUID) *sweeper.Game {
+	h.t.Helper()
+	g, err := h.svc.GetGame(h.ctx, id)
+	if err != nil {
+		h.t.Fatalf("getting game: %v", err)
+	}
+	if g.State != sweeper.GameOngoing {
+		h.t.Fatalf("want game %s to be ongoing, got %v", id, g.State)
+	}
+	return g
+}
+
+// win flags a mine, waits for took to pass, then reveals every safe Cell.
+func (h *harness) win(id uuid.UUID, player sweeper.PlayerID, took time.Duration) {
+	h.t.Helper()
+
+	g := h.game(id)
+	if _, err := h.svc.MakeMove(h.ctx, id, player, h.mine(g), sweeper.CellFlagged); err != nil {
+		h.t.Fatalf("flagging mine: %v", err)
+	}
+	h.clock.Advance(took)
+
+	for i := range g.Cells.Len() {
+		ref := g.Cells.Ref(i)
+		if g.Cells.Nth(i).ContainsMine() {
+			continue
+		}
+		g, err := h.svc.MakeMove(h.ctx, id, player, ref, sweeper.CellRevealed)
+		switch {
+		case errors.Is(err, sweeper.ErrRevealed):
+		case err != nil:
+			h.t.Fatalf("revealing %v: %v", ref, err)
+		case g.State == sweeper.GameWon:
+			return
+		}
+	}
+	h.t.Fatal("revealing every safe cell did not win")
+}
+
+func (h *harness) lose(id uuid.UUID, player sweeper.PlayerID) {
+	h.t.Helper()
+
+	if _, err := h.svc.MakeMove(h.ctx, id, player, h.mine(h.game(id)), sweeper.CellRevealed); err != nil {
+		h.t.Fatalf("revealing mine: %v", err)
+	}
+}
+
+// waitFor waits for the Tournament to satisfy cond, returning it.
+func (h *harness) waitFor(id uuid.UUID, cond func(t *tournament.Tournament) bool) *tournament.Tournament {
+	h.t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		t, err := h.m.Get(h.ctx, id)
+		if err != nil {
+			h.t.Fatalf("getting tournament: %v", err)
+		}
+		if cond(t) {
+			return t
+		}
+
+		select {
+		case <-timeout:
+			h.t.Fatalf("timed out waiting for tournament: %+v", t)
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+// heats returns a condition satisfied once the Pairing has started n heats.
+func heats(round, pairing, n int) func(t *tournament.Tournament) bool {
+	return func(t *tournament.Tournament) bool {
+		return len(t.Rounds) > round && len(t.Rounds[round].Pairings[pairing].Heats) >= n
+	}
+}
+
+func TestManager_bestOf(t *testing.T) {
+	t.Parallel()
+
+	h := newHarness(t)
+	tourney, err := h.m.Create(h.ctx, "org", []sweeper.PlayerID{"alice", "bob", "carol"}, board, tournament.FormatBestOf, 3)
+	if err != nil {
+		t.Fatalf("creating tournament: %v", err)
+	}
+
+	// the top seed gets a bye, leaving bob to play carol.
+	round := tourney.Rounds[0]
+	if p := round.Pairings[0]; p.Winner != "alice" || len(p.Heats) != 0 {
+		t.Errorf("want alice to have a bye, got %+v", p)
+	}
+
+	heat := round.Pairings[1].Heats[0]
+	h.win(heat.GameIDs[0], "bob", time.Second)
+
+	// carol losing lets bob win, as the last player not to lose.
+	heat = h.waitFor(tourney.ID, heats(0, 1, 2)).Rounds[0].Pairings[1].Heats[1]
+	h.lose(heat.GameIDs[1], "carol")
+
+	tourney = h.waitFor(tourney.ID, heats(1, 0, 1))
+	if p := tourney.Rounds[0].Pairings[1]; p.Winner != "bob" || p.Wins[0] != 2 {
+		t.Errorf("want bob to win 2-0, got %+v", p)
+	}
+
+	final := tourney.Rounds[1].Pairings[0]
+	if final.Players[0] != "alice" || final.Players[1] != "bob" {
+		t.Fatalf("want alice to play bob in the final, got %v", final.Players)
+	}
+	h.lose(final.Heats[0].GameIDs[0], "alice")
+
+	heat = h.waitFor(tourney.ID, heats(1, 0, 2)).Rounds[1].Pairings[0].Heats[1]
+	h.win(heat.GameIDs[0], "alice", time.Second)
+
+	heat = h.waitFor(tourney.ID, heats(1, 0, 3)).Rounds[1].Pairings[0].Heats[2]
+	h.win(heat.GameIDs[0], "alice", time.Second)
+
+	tourney = h.waitFor(tourney.ID, (*tournament.Tournament).Finished)
+	if tourney.Winner != "alice" {
+		t.Errorf("want alice to win, got %q", tourney.Winner)
+	}
+	if w := tourney.Rounds[1].Pairings[0].Wins; w[0] != 2 || w[1] != 1 {
+		t.Errorf("want alice to win the final 2-1, got %v", w)
+	}
+}
+
+func TestManager_fastest(t *testing.T) {
+	t.Parallel()
+
+	h := newHarness(t)
+	tourney, err := h.m.Create(h.ctx, "org", []sweeper.PlayerID{"alice", "bob"}, board, tournament.FormatFastest, 0)
+	if err != nil {
+		t.Fatalf("creating tournament: %v", err)
+	}
+
+	// bob makes his moves faster, but studying the board before his first move
+	// counts too.
+	heat := tourney.Rounds[0].Pairings[0].Heats[0]
+	h.win(heat.GameIDs[0], "alice", 10*time.Second)
+	h.clock.Advance(time.Minute)
+	h.win(heat.GameIDs[1], "bob", time.Second)
+
+	tourney = h.waitFor(tourney.ID, (*tournament.Tournament).Finished)
+	if tourney.Winner != "alice" {
+		t.Errorf("want alice to win, got %q", tourney.Winner)
+	}
+	if times := tourney.Rounds[0].Pairings[0].Heats[0].Times; times[0] != 10*time.Second || times[1] != 71*time.Second {
+		t.Errorf("want times [10s 1m11s], got %v", times)
+	}
+}
+
+func TestManager_instantWin(t *testing.T) {
+	t.Parallel()
+
+	h := newHarness(t)
+	tourney, err := h.m.Create(h.ctx, "org", []sweeper.PlayerID{"alice", "bob"}, board, tournament.FormatFastest, 0)
+	if err != nil {
+		t.Fatalf("creating tournament: %v", err)
+	}
+
+	// alice wins without the clock moving, and bob doesn't win at all.
+	heat := tourney.Rounds[0].Pairings[0].Heats[0]
+	h.win(heat.GameIDs[0], "alice", 0)
+	h.lose(heat.GameIDs[1], "bob")
+
+	tourney = h.waitFor(tourney.ID, (*tournament.Tournament).Finished)
+	if times := tourney.Rounds[0].Pairings[0].Heats[0].Times; times[0] != 0 || times[1] >= 0 {
+		t.Errorf("want times [0s, negative], got %v", times)
+	}
+}
+
+// watchCounter counts the Games being spectated.
+type watchCounter struct {
+	sweeper.Service
+	watching atomic.Int32
+}
+
+func (w *watchCounter) Spectate(
+	ctx context.Context,
+	gameID uuid.UUID,
+	delay time.Duration,
+	send func(g *sweeper.Game) error,
+) error {
+	w.watching.Add(1)
+	defer w.watching.Add(-1)
+	return w.Service.Spectate(ctx, gameID, delay, send)
+}
+
+func TestManager_stopsWatchingDecidedHeats(t *testing.T) {
+	t.Parallel()
+
+	h := newHarness(t)
+	svc := &watchCounter{Service: h.svc}
+	h.m = tournament.New(svc, h.store, uuid.New, slog.New(slog.NewTextHandler(h.logs, nil)))
+
+	tourney, err := h.m.Create(h.ctx, "org", []sweeper.PlayerID{"alice", "bob"}, board, tournament.FormatBestOf, 1)
+	if err != nil {
+		t.Fatalf("creating tournament: %v", err)
+	}
+
+	// bob's game is never finished, but alice winning decides the heat.
+	h.win(tourney.Rounds[0].Pairings[0].Heats[0].GameIDs[0], "alice", time.Second)
+	h.waitFor(tourney.ID, (*tournament.Tournament).Finished)
+
+	timeout := time.After(5 * time.Second)
+	for svc.watching.Load() != 0 {
+		select {
+		case <-timeout:
+			t.Fatalf("still watching %d games", svc.watching.Load())
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+// failingStarts fails to start any Match once fail is set.
+type failingStarts struct {
+	sweeper.Service
+	fail atomic.Bool
+}
+
+func (f *failingStarts) StartMatch(
+	ctx context.Context,
+	players []sweeper.PlayerID,
+	board sweeper.Board,
+) (*sweeper.Match, error) {
+	if f.fail.Load() {
+		return nil, errors.New("service unavailable")
+	}
+	return f.Service.StartMatch(ctx, players, board)
+}
+
+func TestManager_logsUnsettledHeats(t *testing.T) {
+	t.Parallel()
+
+	h := newHarness(t)
+	svc := &failingStarts{Service: h.svc}
+	h.m = tournament.New(svc, h.store, uuid.New, slog.New(slog.NewTextHandler(h.logs, nil)))
+
+	tourney, err := h.m.Create(h.ctx, "org", []sweeper.PlayerID{"alice", "bob"}, board, tournament.FormatBestOf, 3)
+	if err != nil {
+		t.Fatalf("creating tournament: %v", err)
+	}
+
+	// alice winning decides the heat, but the next one can't be started.
+	svc.fail.Store(true)
+	h.win(tourney.Rounds[0].Pairings[0].Heats[0].GameIDs[0], "alice", time.Second)
+
+	tourney = h.waitFor(tourney.ID, func(t *tournament.Tournament) bool {
+		return t.Rounds[0].Pairings[0].Heats[0].Finished
+	})
+	if p := tourney.Rounds[0].Pairings[0]; len(p.Heats) != 1 || p.Wins[0] != 1 {
+		t.Errorf("want alice to have won the only heat, got %+v", p)
+	}
+	if logs := h.logs.String(); !strings.Contains(logs, "failed to settle heat") || !strings.Contains(logs, "service unavailable") {
+		t.Errorf("want the failure to be logged, got %q", logs)
+	}
+}
+
+func TestManager_Create_invalid(t *testing.T) {
+	t.Parallel()
+
+	h := newHarness(t)
+	players := []sweeper.PlayerID{"alice", "bob"}
+
+	if _, err := h.m.Create(h.ctx, "", players[:1], board, tournament.FormatBestOf, 1); !errors.Is(err, sweeper.ErrTooFewPlayers) {
+		t.Errorf("want error %v, got %v", sweeper.ErrTooFewPlayers, err)
+	}
+	if _, err := h.m.Create(h.ctx, "", players, board, tournament.FormatBestOf, 0); !errors.Is(err, tournament.ErrInvalidTournament) {
+		t.Errorf("want error %v, got %v", tournament.ErrInvalidTournament, err)
+	}
+}